@@ -1,15 +1,20 @@
 package main
 
 import (
+	"os"
+
 	"go.uber.org/zap"
 
 	"github.com/geometry-labs/icon-transactions/api/healthcheck"
 	"github.com/geometry-labs/icon-transactions/api/routes"
+	"github.com/geometry-labs/icon-transactions/api/routes/rpc"
 	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/global"
 	"github.com/geometry-labs/icon-transactions/logging"
 	"github.com/geometry-labs/icon-transactions/metrics"
 	_ "github.com/geometry-labs/icon-transactions/models" // for swagger docs
+	"github.com/geometry-labs/icon-transactions/networks"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
 
@@ -19,6 +24,41 @@ func main() {
 	logging.Init()
 	zap.S().Debug("Main: Starting logging with level ", config.Config.LogLevel)
 
+	// Migrate command
+	// NOTE `main migrate [up [steps] | down [steps] | status]` migrates the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		var err error
+		if networks.Enabled() {
+			// Schema of each network
+			err = networks.RunProcesses()
+		} else {
+			err = crud.RunMigrateCommand(os.Args[2:])
+		}
+		if err != nil {
+			zap.S().Fatal("Main: ", err.Error())
+		}
+		return
+	}
+
+	// Networks
+	// NOTE each network is served by a child process, this process runs the gateway
+	if networks.Enabled() {
+		networks.StartProcesses()
+
+		routes.Start()
+		healthcheck.Start()
+
+		global.WaitShutdownSig()
+		networks.StopProcesses()
+		return
+	}
+
+	// Schema
+	err := crud.CheckMigrations()
+	if err != nil {
+		zap.S().Fatal("Main: ", err.Error())
+	}
+
 	// Start Prometheus client
 	// Go routine starts in function
 	metrics.Start()
@@ -32,6 +72,10 @@ func main() {
 	// Go routine starts in function
 	routes.Start()
 
+	// Start gRPC server
+	// Go routine starts in function
+	rpc.Start()
+
 	// Start Health server
 	// Go routine starts in function
 	healthcheck.Start()
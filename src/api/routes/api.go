@@ -6,6 +6,7 @@ import (
 
 	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/global"
+	"github.com/geometry-labs/icon-transactions/networks"
 	"go.uber.org/zap"
 
 	swagger "github.com/arsmn/fiber-swagger/v2"
@@ -14,6 +15,11 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 
 	_ "github.com/geometry-labs/icon-transactions/api/docs" // import swagger docs
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/api/routes/cache"
+	"github.com/geometry-labs/icon-transactions/api/routes/gateway"
+	"github.com/geometry-labs/icon-transactions/api/routes/gql"
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
 	"github.com/geometry-labs/icon-transactions/api/routes/rest"
 	"github.com/geometry-labs/icon-transactions/api/routes/ws"
 )
@@ -23,7 +29,14 @@ import (
 // @description This is a sample server server.
 func Start() {
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		ProxyHeader:  config.Config.RestProxyHeader,
+		ErrorHandler: apierrors.ErrorHandler,
+	})
+
+	// Request ID middleware
+	// NOTE ids are sent in X-Request-ID and in the request_id of errors
+	app.Use(apierrors.RequestID())
 
 	// Logging middleware
 	app.Use(func(c *fiber.Ctx) error {
@@ -33,6 +46,18 @@ func Start() {
 		return c.Next()
 	})
 
+	// Network gateway
+	// NOTE the api of each network runs in its own process with the middlewares below
+	if networks.Enabled() {
+		app.Get("/version", handlerVersion)
+		app.Get("/metadata", handlerMetadata)
+
+		gateway.AddHandlers(app)
+
+		go app.Listen(":" + config.Config.Port)
+		return
+	}
+
 	// CORS Middleware
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:  config.Config.CORSAllowOrigins,
@@ -41,6 +66,9 @@ func Start() {
 		ExposeHeaders: config.Config.CORSExposeHeaders,
 	}))
 
+	// API key and rate limit Middleware
+	app.Use(limits.New())
+
 	// Compression Middleware
 	app.Use(compress.New(compress.Config{
 		// refer to gofiber/fiber/blob/v1.14.6/middleware/compress.go#L17
@@ -50,6 +78,9 @@ func Start() {
 		},
 	}))
 
+	// Response cache Middleware
+	app.Use(cache.New())
+
 	// Swagger docs
 	app.Get(config.Config.RestPrefix+"/transactions/docs/*", swagger.Handler)
 
@@ -59,9 +90,10 @@ func Start() {
 
 	// Add handlers
 	rest.TransactionsAddHandlers(app)
+	gql.TransactionsAddHandlers(app)
 	ws.TransactionsAddHandlers(app)
 
-	go app.Listen(":" + config.Config.Port)
+	go app.Listen(config.Config.RestHost + ":" + config.Config.Port)
 }
 
 // Version
@@ -0,0 +1,164 @@
+package apierrors
+
+import (
+	"errors"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"go.uber.org/zap"
+)
+
+const (
+	CodeInvalidParameter = "invalid_parameter"
+	CodeUnauthorized     = "unauthorized"
+	CodeNotFound         = "not_found"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeRateLimited      = "rate_limited"
+	CodeInternal         = "internal"
+	CodeUnavailable      = "unavailable"
+
+	// Locals key of the request id, set by the requestid middleware
+	requestIDLocalsKey = "requestid"
+)
+
+// APIError - error sent to clients
+type APIError struct {
+	// Machine readable error, one of the codes above
+	Code string `json:"code" example:"invalid_parameter"`
+
+	Message string `json:"message" example:"limit must be between 1 and 100"`
+
+	// Query, path, or body param of an invalid_parameter error
+	Field string `json:"field,omitempty" example:"limit"`
+
+	RequestID string `json:"request_id" example:"3f5d6c2a-2b8e-4c1e-9a4e-0f1d2c3b4a5e"`
+
+	Status int `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// Envelope - body of every error response
+type Envelope struct {
+	Error *APIError `json:"error"`
+}
+
+// InvalidParameter - 422, a param is missing or invalid
+func InvalidParameter(field string, message string) *APIError {
+	return &APIError{
+		Code:    CodeInvalidParameter,
+		Message: message,
+		Field:   field,
+		Status:  fiber.StatusUnprocessableEntity,
+	}
+}
+
+// Unauthorized - 401, the api key is invalid
+func Unauthorized(message string) *APIError {
+	return &APIError{
+		Code:    CodeUnauthorized,
+		Message: message,
+		Status:  fiber.StatusUnauthorized,
+	}
+}
+
+// NotFound - 404, the requested row does not exist
+func NotFound(message string) *APIError {
+	return &APIError{
+		Code:    CodeNotFound,
+		Message: message,
+		Status:  fiber.StatusNotFound,
+	}
+}
+
+// RateLimited - 429, the rate limit of the api key or ip is exceeded
+func RateLimited(message string) *APIError {
+	return &APIError{
+		Code:    CodeRateLimited,
+		Message: message,
+		Status:  fiber.StatusTooManyRequests,
+	}
+}
+
+// Internal - 500, the request is valid but could not be served
+// NOTE causes are logged by the handlers, messages should not leak them
+func Internal(message string) *APIError {
+	return &APIError{
+		Code:    CodeInternal,
+		Message: message,
+		Status:  fiber.StatusInternalServerError,
+	}
+}
+
+// Unavailable - 503, a backing service of the request is down
+func Unavailable(message string) *APIError {
+	return &APIError{
+		Code:    CodeUnavailable,
+		Message: message,
+		Status:  fiber.StatusServiceUnavailable,
+	}
+}
+
+// Send - send an error in the error envelope
+// NOTE errors that are not an APIError are sent as internal errors
+func Send(c *fiber.Ctx, err error) error {
+	apiError := newAPIError(err)
+	apiError.RequestID = requestID(c)
+
+	return c.Status(apiError.Status).JSON(&Envelope{Error: apiError})
+}
+
+// ErrorHandler - fiber error handler, errors returned by handlers and middlewares
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	return Send(c, err)
+}
+
+// newAPIError - copy of an APIError, or an APIError from a fiber or unknown error
+func newAPIError(err error) *APIError {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		// NOTE errors can be package vars, request ids are set on a copy
+		copied := *apiError
+		return &copied
+	}
+
+	var fiberError *fiber.Error
+	if errors.As(err, &fiberError) {
+		switch fiberError.Code {
+		case fiber.StatusNotFound:
+			return NotFound(fiberError.Message)
+		case fiber.StatusMethodNotAllowed:
+			return &APIError{
+				Code:    CodeMethodNotAllowed,
+				Message: fiberError.Message,
+				Status:  fiber.StatusMethodNotAllowed,
+			}
+		case fiber.StatusUnprocessableEntity, fiber.StatusBadRequest:
+			return InvalidParameter("", fiberError.Message)
+		}
+	}
+
+	zap.S().Warn("API ERROR: ", err.Error())
+	return Internal("internal error")
+}
+
+// requestID - id set by the requestid middleware, "" if missing
+func requestID(c *fiber.Ctx) string {
+	id, ok := c.Locals(requestIDLocalsKey).(string)
+	if ok == false {
+		return string(c.Response().Header.Peek(fiber.HeaderXRequestID))
+	}
+
+	return id
+}
+
+// RequestID - requestid middleware, sets X-Request-ID on responses
+// NOTE ids sent by clients in X-Request-ID are kept
+func RequestID() fiber.Handler {
+	return requestid.New(requestid.Config{
+		Header:     fiber.HeaderXRequestID,
+		ContextKey: requestIDLocalsKey,
+	})
+}
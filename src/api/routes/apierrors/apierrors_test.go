@@ -0,0 +1,70 @@
+//+build unit
+
+package apierrors
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	assert := assert.New(t)
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: ErrorHandler,
+	})
+	app.Use(RequestID())
+	app.Get("/invalid", func(c *fiber.Ctx) error {
+		return Send(c, InvalidParameter("limit", "limit must be between 1 and 100"))
+	})
+	app.Get("/not-found", func(c *fiber.Ctx) error {
+		return Send(c, NotFound("no transaction found"))
+	})
+	app.Get("/unknown", func(c *fiber.Ctx) error {
+		return Send(c, errors.New("connection refused"))
+	})
+	app.Get("/returned", func(c *fiber.Ctx) error {
+		return RateLimited("rate limit exceeded")
+	})
+
+	tests := []struct {
+		path    string
+		status  int
+		code    string
+		message string
+		field   string
+	}{
+		{"/invalid", 422, CodeInvalidParameter, "limit must be between 1 and 100", "limit"},
+		{"/not-found", 404, CodeNotFound, "no transaction found", ""},
+		{"/unknown", 500, CodeInternal, "internal error", ""},
+		{"/returned", 429, CodeRateLimited, "rate limit exceeded", ""},
+		{"/missing-route", 404, CodeNotFound, "Cannot GET /missing-route", ""},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("GET", test.path, nil)
+		req.Header.Set(fiber.HeaderXRequestID, "request-"+test.path)
+
+		resp, err := app.Test(req)
+		assert.Equal(nil, err)
+		assert.Equal(test.status, resp.StatusCode, test.path)
+		assert.Equal(fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType), test.path)
+
+		bytes, err := ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		envelope := &Envelope{}
+		err = json.Unmarshal(bytes, envelope)
+		assert.Equal(nil, err)
+		assert.Equal(test.code, envelope.Error.Code, test.path)
+		assert.Equal(test.message, envelope.Error.Message, test.path)
+		assert.Equal(test.field, envelope.Error.Field, test.path)
+		assert.Equal("request-"+test.path, envelope.Error.RequestID, test.path)
+	}
+}
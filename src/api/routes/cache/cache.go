@@ -0,0 +1,233 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/redis"
+)
+
+// Headers of the handlers kept with cached responses
+var cachedHeaders = []string{
+	fiber.HeaderContentType,
+	"X-TOTAL-COUNT",
+	"X-TOTAL-COUNT-ESTIMATED",
+}
+
+// Query params not part of the cache key
+var ignoredParams = map[string]bool{
+	"api_key": true,
+}
+
+// entry - cached response
+type entry struct {
+	Status       int               `json:"status"`
+	Headers      map[string]string `json:"headers"`
+	Body         []byte            `json:"body"`
+	ETag         string            `json:"etag"`
+	LastModified string            `json:"last_modified"`
+}
+
+// New - middleware caching the responses of rest GET requests
+// NOTE keys include the tip block number, entries are dropped when a new block is loaded
+func New() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		if config.Config.RestCacheEnabled == false || isCacheable(c) == false {
+			return c.Next()
+		}
+
+		tipBlockNumber, err := getTipBlockNumber()
+		if err != nil {
+			zap.S().Warn("Cache ERROR: ", err.Error())
+			return c.Next()
+		}
+		key := cacheKey(tipBlockNumber, c)
+
+		// Hit
+		cached, err := getEntry(key)
+		if err != nil {
+			zap.S().Warn("Cache ERROR: ", err.Error())
+		} else if cached != nil {
+			return sendEntry(c, cached)
+		}
+
+		// Miss
+		err = c.Next()
+		if err != nil {
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if (status != 200 && status != 204) || c.Response().IsBodyStream() {
+			// NOTE exports are streamed and not cached
+			return nil
+		}
+
+		body := c.Response().Body()
+		hash := sha256.Sum256(body)
+		newEntry := &entry{
+			Status:       status,
+			Headers:      map[string]string{},
+			Body:         append([]byte{}, body...),
+			ETag:         `"` + hex.EncodeToString(hash[:16]) + `"`,
+			LastModified: time.Now().UTC().Format(http.TimeFormat),
+		}
+		for _, header := range cachedHeaders {
+			value := c.Response().Header.Peek(header)
+			if len(value) > 0 {
+				newEntry.Headers[header] = string(value)
+			}
+		}
+
+		err = setEntry(key, newEntry)
+		if err != nil {
+			zap.S().Warn("Cache ERROR: ", err.Error())
+		}
+
+		setValidators(c, newEntry)
+		if isNotModified(c, newEntry) {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+
+		return nil
+	}
+}
+
+// isCacheable - GET requests to the rest endpoints, without exports
+func isCacheable(c *fiber.Ctx) bool {
+	if c.Method() != fiber.MethodGet {
+		return false
+	}
+
+	path := c.Path()
+	if strings.HasPrefix(path, config.Config.RestPrefix) == false ||
+		strings.Contains(path, "/docs/") ||
+		strings.HasSuffix(path, "/graphql") {
+		return false
+	}
+
+	format := c.Query("format")
+	if format != "" && format != "json" {
+		return false
+	}
+	accept := c.Get(fiber.HeaderAccept)
+	if format == "" && (strings.Contains(accept, "text/csv") || strings.Contains(accept, "application/x-ndjson")) {
+		return false
+	}
+
+	return true
+}
+
+// cacheKey - tip block number, path, and sorted query params of a request
+// NOTE the max page size is part of the key, pages over the limit of a tier are not shared
+func cacheKey(tipBlockNumber uint64, c *fiber.Ctx) string {
+	params := []string{}
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		if ignoredParams[string(key)] {
+			return
+		}
+
+		params = append(params, string(key)+"="+string(value))
+	})
+	sort.Strings(params)
+
+	hash := sha256.Sum256([]byte(
+		strconv.Itoa(limits.MaxPageSize(c)) + c.Path() + "?" + strings.Join(params, "&"),
+	))
+
+	return config.Config.RedisKeyPrefix + "cache_" + strconv.FormatUint(tipBlockNumber, 10) + "_" + hex.EncodeToString(hash[:])
+}
+
+func sendEntry(c *fiber.Ctx, cached *entry) error {
+	setValidators(c, cached)
+	if isNotModified(c, cached) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	for header, value := range cached.Headers {
+		c.Set(header, value)
+	}
+
+	c.Status(cached.Status)
+	return c.Send(cached.Body)
+}
+
+func setValidators(c *fiber.Ctx, cached *entry) {
+	c.Set(fiber.HeaderETag, cached.ETag)
+	c.Set(fiber.HeaderLastModified, cached.LastModified)
+}
+
+// isNotModified - check If-None-Match, then If-Modified-Since
+func isNotModified(c *fiber.Ctx, cached *entry) bool {
+	ifNoneMatch := c.Get(fiber.HeaderIfNoneMatch)
+	if ifNoneMatch != "" {
+		for _, etag := range strings.Split(ifNoneMatch, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == cached.ETag || etag == "*" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(c.Get(fiber.HeaderIfModifiedSince))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(cached.LastModified)
+	if err != nil {
+		return false
+	}
+
+	return lastModified.After(ifModifiedSince) == false
+}
+
+///////////
+// Store //
+///////////
+
+var getTipBlockNumber = func() (uint64, error) {
+	return redis.GetRedisClient().GetTipBlockNumber()
+}
+
+// getEntry - cached response of a key, nil if missing
+var getEntry = func(key string) (*entry, error) {
+	value, err := redis.GetRedisClient().GetCacheValue(key)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	cached := &entry{}
+	err = json.Unmarshal([]byte(value), cached)
+	if err != nil {
+		return nil, err
+	}
+
+	return cached, nil
+}
+
+var setEntry = func(key string, cached *entry) error {
+	value, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+
+	return redis.GetRedisClient().SetCacheValue(
+		key,
+		string(value),
+		time.Duration(config.Config.RestCacheTTLSeconds)*time.Second,
+	)
+}
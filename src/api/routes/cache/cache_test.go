@@ -0,0 +1,150 @@
+//+build unit
+
+package cache
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+func init() {
+	config.ReadEnvironment()
+}
+
+// newTestApp - app with an in memory store
+// Returns: app, tip block number, handler calls
+func newTestApp() (*fiber.App, *uint64, *int) {
+	entries := map[string]*entry{}
+	tipBlockNumber := uint64(1)
+	handlerCalls := 0
+
+	getTipBlockNumber = func() (uint64, error) {
+		return tipBlockNumber, nil
+	}
+	getEntry = func(key string) (*entry, error) {
+		return entries[key], nil
+	}
+	setEntry = func(key string, cached *entry) error {
+		entries[key] = cached
+		return nil
+	}
+
+	app := fiber.New()
+	app.Use(New())
+
+	handler := func(c *fiber.Ctx) error {
+		handlerCalls++
+
+		c.Append("X-TOTAL-COUNT", "10")
+		return c.JSON(map[string]int{"calls": handlerCalls})
+	}
+	app.Get(config.Config.RestPrefix+"/transactions", handler)
+	app.Post(config.Config.RestPrefix+"/transactions", handler)
+
+	return app, &tipBlockNumber, &handlerCalls
+}
+
+func TestCache(t *testing.T) {
+	assert := assert.New(t)
+
+	app, tipBlockNumber, handlerCalls := newTestApp()
+	path := config.Config.RestPrefix + "/transactions"
+
+	// Miss
+	resp, err := app.Test(httptest.NewRequest("GET", path+"?limit=10&skip=0", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+	assert.Equal(1, *handlerCalls)
+	etag := resp.Header.Get("ETag")
+	assert.NotEqual("", etag)
+	assert.NotEqual("", resp.Header.Get("Last-Modified"))
+
+	// Hit, params are sorted and api keys ignored
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?skip=0&limit=10&api_key=foo", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+	assert.Equal(1, *handlerCalls)
+	assert.Equal(etag, resp.Header.Get("ETag"))
+	assert.Equal("10", resp.Header.Get("X-TOTAL-COUNT"))
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal(`{"calls":1}`, string(bytes))
+
+	// Not modified
+	req := httptest.NewRequest("GET", path+"?limit=10&skip=0", nil)
+	req.Header.Set("If-None-Match", etag)
+	resp, err = app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(304, resp.StatusCode)
+	assert.Equal(1, *handlerCalls)
+
+	req = httptest.NewRequest("GET", path+"?limit=10&skip=0", nil)
+	req.Header.Set("If-Modified-Since", resp.Header.Get("Last-Modified"))
+	resp, err = app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(304, resp.StatusCode)
+
+	// New tip
+	*tipBlockNumber = 2
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?limit=10&skip=0", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+	assert.Equal(2, *handlerCalls)
+	assert.NotEqual(etag, resp.Header.Get("ETag"))
+
+	// Exports and POST requests are not cached
+	for i := 0; i < 2; i++ {
+		_, err = app.Test(httptest.NewRequest("GET", path+"?format=csv", nil))
+		assert.Equal(nil, err)
+		_, err = app.Test(httptest.NewRequest("POST", path, nil))
+		assert.Equal(nil, err)
+	}
+	assert.Equal(6, *handlerCalls)
+}
+
+func TestIsNotModified(t *testing.T) {
+	assert := assert.New(t)
+
+	cached := &entry{ETag: `"abc"`, LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString(strconv.FormatBool(isNotModified(c, cached)))
+	})
+
+	tests := []struct {
+		header   string
+		value    string
+		expected string
+	}{
+		{"If-None-Match", `"abc"`, "true"},
+		{"If-None-Match", `W/"abc"`, "true"},
+		{"If-None-Match", `"xyz", "abc"`, "true"},
+		{"If-None-Match", `"xyz"`, "false"},
+		{"If-Modified-Since", "Mon, 02 Jan 2006 15:04:05 GMT", "true"},
+		{"If-Modified-Since", "Mon, 02 Jan 2006 15:04:04 GMT", "false"},
+		{"", "", "false"},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		if test.header != "" {
+			req.Header.Set(test.header, test.value)
+		}
+
+		resp, err := app.Test(req)
+		assert.Equal(nil, err)
+
+		bytes, err := ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+		assert.Equal(test.expected, string(bytes), test.header+": "+test.value)
+	}
+}
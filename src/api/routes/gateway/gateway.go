@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	fws "github.com/fasthttp/websocket"
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/networks"
+)
+
+const localsTargetURL = "gateway_target_url"
+
+// NOTE responses are read in full before they are sent, including exports
+// Bodies past GATEWAY_MAX_RESPONSE_BODY_SIZE and requests past GATEWAY_TIMEOUT_SECONDS fail
+var client *fasthttp.Client
+var clientOnce sync.Once
+
+func getClient() *fasthttp.Client {
+	clientOnce.Do(func() {
+		timeout := time.Duration(config.Config.GatewayTimeoutSeconds) * time.Second
+
+		client = &fasthttp.Client{
+			NoDefaultUserAgentHeader: true,
+			MaxResponseBodySize:      config.Config.GatewayMaxResponseBodySize,
+			ReadTimeout:              timeout,
+			WriteTimeout:             timeout,
+		}
+	})
+
+	return client
+}
+
+// AddHandlers - proxy rest and websocket requests to the api process of each network
+// NOTE paths without a network, ex /api/v1/transactions, go to the first network of NETWORKS
+func AddHandlers(app *fiber.App) {
+	app.All(config.Config.RestPrefix+"/*", handlerProxy)
+	app.Get(config.Config.WebsocketPrefix+"/*", handlerProxyWebsocket)
+}
+
+// route - network of a path and the path in the network process
+// Returns: network (nil if NETWORKS is empty), path
+func route(prefix string, path string) (*networks.Network, string) {
+	networkPath := strings.TrimPrefix(path, prefix)
+
+	name := strings.SplitN(strings.TrimPrefix(networkPath, "/"), "/", 2)[0]
+	network := networks.GetNetwork(name)
+	if network != nil {
+		return network, path
+	}
+
+	// Default network
+	allNetworks := networks.GetNetworks()
+	if len(allNetworks) == 0 {
+		return nil, ""
+	}
+
+	return allNetworks[0], prefix + "/" + allNetworks[0].Name + networkPath
+}
+
+func handlerProxy(c *fiber.Ctx) error {
+	network, path := route(config.Config.RestPrefix, c.Path())
+	if network == nil {
+		return apierrors.Send(c, apierrors.NotFound("no network found"))
+	}
+
+	url := "http://127.0.0.1:" + network.Port + path
+	if queryString := c.Request().URI().QueryString(); len(queryString) > 0 {
+		url += "?" + string(queryString)
+	}
+
+	// NOTE network processes limit requests by the forwarded ip
+	ip := c.IP()
+
+	req := c.Request()
+	req.SetRequestURI(url)
+	req.Header.Del(fiber.HeaderConnection)
+	req.Header.Set(fiber.HeaderXForwardedFor, ip)
+
+	err := getClient().Do(req, c.Response())
+	if errors.Is(err, fasthttp.ErrBodyTooLarge) {
+		c.Response().Reset()
+		return apierrors.Send(c, apierrors.InvalidParameter("", "response too large, use a smaller limit"))
+	} else if err != nil {
+		zap.S().Warn("Gateway ERROR: ", err.Error())
+		c.Response().Reset()
+		return apierrors.Send(c, apierrors.Unavailable("network "+network.Name+" unavailable"))
+	}
+	c.Response().Header.Del(fiber.HeaderConnection)
+
+	return nil
+}
+
+func handlerProxyWebsocket(c *fiber.Ctx) error {
+	if websocket.IsWebSocketUpgrade(c) == false {
+		return fiber.ErrUpgradeRequired
+	}
+
+	network, path := route(config.Config.WebsocketPrefix, c.Path())
+	if network == nil {
+		return apierrors.Send(c, apierrors.NotFound("no network found"))
+	}
+
+	c.Locals(localsTargetURL, "ws://127.0.0.1:"+network.Port+path)
+	return websocketProxy(c)
+}
+
+var websocketProxy = websocket.New(func(c *websocket.Conn) {
+	targetURL, _ := c.Locals(localsTargetURL).(string)
+
+	target, _, err := fws.DefaultDialer.Dial(targetURL, nil)
+	if err != nil {
+		zap.S().Warn("Gateway ERROR: ", err.Error())
+		return
+	}
+	defer target.Close()
+
+	// NOTE returns when either side closes, the other copy then fails and exits
+	errChan := make(chan error, 2)
+	go copyMessages(target, c.Conn, errChan)
+	go copyMessages(c.Conn, target, errChan)
+	<-errChan
+})
+
+// copyMessages - copy messages from src to dst until an error
+func copyMessages(dst *fws.Conn, src *fws.Conn, errChan chan error) {
+	for {
+		messageType, message, err := src.ReadMessage()
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		err = dst.WriteMessage(messageType, message)
+		if err != nil {
+			errChan <- err
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+//+build unit
+
+package gateway
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+func init() {
+	config.ReadEnvironment()
+}
+
+func TestRoute(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.Networks = nil
+	network, _ := route("/api/v1", "/api/v1/lisbon/transactions")
+	assert.Nil(network)
+
+	config.Config.Networks = []string{"mainnet", "lisbon"}
+	defer func() { config.Config.Networks = nil }()
+
+	tests := []struct {
+		path            string
+		expectedNetwork string
+		expectedPath    string
+	}{
+		{"/api/v1/lisbon/transactions", "lisbon", "/api/v1/lisbon/transactions"},
+		{"/api/v1/mainnet/transactions/details/0x1", "mainnet", "/api/v1/mainnet/transactions/details/0x1"},
+		{"/api/v1/transactions", "mainnet", "/api/v1/mainnet/transactions"},
+		{"/api/v1/berlin/transactions", "mainnet", "/api/v1/mainnet/berlin/transactions"},
+	}
+
+	for _, test := range tests {
+		network, path := route("/api/v1", test.path)
+		assert.Equal(test.expectedNetwork, network.Name, test.path)
+		assert.Equal(test.expectedPath, path, test.path)
+	}
+}
+
+func TestHandlerProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.Networks = []string{"mainnet", "lisbon"}
+	config.Config.Port = "18000"
+	defer func() { config.Config.Networks = nil }()
+
+	// Lisbon api
+	backend := fiber.New()
+	backend.Get(config.Config.RestPrefix+"/lisbon/transactions", func(c *fiber.Ctx) error {
+		return c.SendString(c.Get(fiber.HeaderXForwardedFor) + "," + c.Query("limit"))
+	})
+	go backend.Listen(":18002")
+	defer backend.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	app := fiber.New()
+	AddHandlers(app)
+
+	// Proxied
+	req := httptest.NewRequest("GET", config.Config.RestPrefix+"/lisbon/transactions?limit=1", nil)
+	req.Header.Set(fiber.HeaderXForwardedFor, "1.1.1.1")
+	resp, err := app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal("0.0.0.0,1", string(bytes))
+
+	// Mainnet api is down
+	resp, err = app.Test(httptest.NewRequest("GET", config.Config.RestPrefix+"/transactions", nil))
+	assert.Equal(nil, err)
+	assert.Equal(503, resp.StatusCode)
+
+	// Response too large
+	config.Config.GatewayMaxResponseBodySize = 4
+	clientOnce = sync.Once{}
+	defer func() {
+		config.Config.GatewayMaxResponseBodySize = 268435456
+		clientOnce = sync.Once{}
+	}()
+
+	resp, err = app.Test(httptest.NewRequest("GET", config.Config.RestPrefix+"/lisbon/transactions?limit=100", nil))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
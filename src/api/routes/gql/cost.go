@@ -0,0 +1,186 @@
+package gql
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// queryCost - estimated number of resolved fields and depth of a query
+// NOTE fields with a first argument multiply the cost of their selections by first
+type queryCost struct {
+	schema    *graphql.Schema
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+
+	// Fragments being walked, to stop on cycles
+	visiting map[string]bool
+
+	cost  int
+	depth int
+}
+
+// getQueryCost - parse a query and estimate its cost and depth
+// Returns: cost, depth, error (if present)
+func getQueryCost(
+	schema *graphql.Schema,
+	query string,
+	variables map[string]interface{},
+	operationName string,
+) (int, int, error) {
+
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	q := &queryCost{
+		schema:    schema,
+		variables: variables,
+		fragments: map[string]*ast.FragmentDefinition{},
+		visiting:  map[string]bool{},
+	}
+
+	// Find operation
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			q.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operation = d
+			}
+		}
+	}
+	if operation == nil {
+		return 0, 0, errors.New("operation not found")
+	}
+	if operation.Operation != ast.OperationTypeQuery {
+		return 0, 0, errors.New("only queries are supported")
+	}
+
+	q.walk(operation.SelectionSet, schema.QueryType(), 1, 1)
+
+	return q.cost, q.depth, nil
+}
+
+func (q *queryCost) walk(
+	selectionSet *ast.SelectionSet,
+	parentType *graphql.Object,
+	multiplier int,
+	depth int,
+) {
+	if selectionSet == nil || parentType == nil {
+		return
+	}
+
+	for _, selection := range selectionSet.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			name := s.Name.Value
+			if strings.HasPrefix(name, "__") {
+				// Introspection
+				continue
+			}
+
+			field, ok := parentType.Fields()[name]
+			if ok == false {
+				// Rejected by validation
+				continue
+			}
+
+			q.cost += multiplier
+			if depth > q.depth {
+				q.depth = depth
+			}
+
+			q.walk(s.SelectionSet, objectType(field.Type), multiplier*q.pageSize(s, field), depth+1)
+
+		case *ast.InlineFragment:
+			fragmentType := parentType
+			if s.TypeCondition != nil {
+				fragmentType, _ = q.schema.Type(s.TypeCondition.Name.Value).(*graphql.Object)
+			}
+
+			q.walk(s.SelectionSet, fragmentType, multiplier, depth)
+
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			fragment, ok := q.fragments[name]
+			if ok == false || q.visiting[name] == true {
+				// Rejected by validation
+				continue
+			}
+
+			fragmentType, _ := q.schema.Type(fragment.TypeCondition.Name.Value).(*graphql.Object)
+
+			q.visiting[name] = true
+			q.walk(fragment.SelectionSet, fragmentType, multiplier, depth)
+			q.visiting[name] = false
+		}
+	}
+}
+
+// pageSize - value of the first argument of a field, 1 if the field has no first argument
+func (q *queryCost) pageSize(field *ast.Field, fieldDefinition *graphql.FieldDefinition) int {
+
+	// Default
+	size := 1
+	for _, arg := range fieldDefinition.Args {
+		if arg.Name() != "first" {
+			continue
+		}
+
+		if defaultValue, ok := arg.DefaultValue.(int); ok {
+			size = defaultValue
+		}
+	}
+
+	// Argument
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			if value, err := strconv.Atoi(v.Value); err == nil {
+				size = value
+			}
+		case *ast.Variable:
+			switch value := q.variables[v.Name.Value].(type) {
+			case float64:
+				size = int(value)
+			case int:
+				size = value
+			}
+		}
+	}
+
+	if size < 1 {
+		size = 1
+	}
+
+	return size
+}
+
+// objectType - object type of a field, unwrapping lists and non nulls
+func objectType(t graphql.Type) *graphql.Object {
+	for {
+		switch w := t.(type) {
+		case *graphql.List:
+			t = w.OfType
+		case *graphql.NonNull:
+			t = w.OfType
+		case *graphql.Object:
+			return w
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//+build unit
+
+package gql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetQueryCost(t *testing.T) {
+	assert := assert.New(t)
+
+	schema := getSchema()
+
+	// Single object
+	cost, depth, err := getQueryCost(schema, `{ transaction(hash: "0x1") { hash block_number } }`, nil, "")
+	assert.Equal(nil, err)
+	assert.Equal(3, cost)
+	assert.Equal(2, depth)
+
+	// Connection defaults to 25 nodes
+	cost, depth, err = getQueryCost(schema, `{ transactions { edges { node { hash } } } }`, nil, "")
+	assert.Equal(nil, err)
+	assert.Equal(1+25+25+25, cost)
+	assert.Equal(4, depth)
+
+	// Nested connections multiply
+	query := `
+	query Holders($first: Int) {
+		token_contract(address: "cx1") {
+			holders(first: $first) {
+				edges { node { ...holder } }
+			}
+		}
+	}
+	fragment holder on TokenHolder {
+		holder_address
+		holder { transactions(first: 10) { edges { node { hash } } } }
+	}`
+	cost, depth, err = getQueryCost(schema, query, map[string]interface{}{"first": float64(5)}, "Holders")
+	assert.Equal(nil, err)
+	assert.Equal(1+1+5+5+5+5+5+50+50+50, cost)
+	assert.Equal(9, depth)
+
+	// Introspection is free
+	cost, _, err = getQueryCost(schema, `{ __schema { queryType { name } } }`, nil, "")
+	assert.Equal(nil, err)
+	assert.Equal(0, cost)
+
+	// Fragment cycles stop
+	_, _, err = getQueryCost(schema, `{ address(address: "hx1") { ...a } } fragment a on Address { address ...a }`, nil, "")
+	assert.Equal(nil, err)
+
+	// Invalid
+	_, _, err = getQueryCost(schema, `{ transaction(`, nil, "")
+	assert.NotEqual(nil, err)
+	_, _, err = getQueryCost(schema, `{ address(address: "hx1") { address } }`, nil, "Missing")
+	assert.NotEqual(nil, err)
+}
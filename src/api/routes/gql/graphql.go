@@ -0,0 +1,132 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// GraphQLBody - body of graphql requests
+type GraphQLBody struct {
+	Query         string                 `json:"query" query:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName" query:"operationName"`
+}
+
+var schema graphql.Schema
+var schemaOnce sync.Once
+
+func getSchema() *graphql.Schema {
+	schemaOnce.Do(func() {
+		var err error
+		schema, err = newSchema()
+		if err != nil {
+			zap.S().Fatal("GraphQL: Unable to create schema: ", err.Error())
+		}
+	})
+
+	return &schema
+}
+
+func TransactionsAddHandlers(app *fiber.App) {
+
+	prefix := config.Config.RestPrefix + "/transactions"
+
+	app.Get(prefix+"/graphql", handlerGraphQL)
+	app.Post(prefix+"/graphql", handlerGraphQL)
+}
+
+// GraphQL
+// @Summary Query transactions with graphql
+// @Description query transactions, internal transactions, token transfers, token holders, token contracts, and addresses
+// @Description lists are connections paged with first and after,
+// @Description queries over the max cost or depth are rejected, cost is the estimated number of resolved fields
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept json
+// @Produce json
+// @Param body body GraphQLBody false "query, variables, and operationName, query and operationName can also be query params"
+// @Router /api/v1/transactions/graphql [post]
+// @Success 200 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+func handlerGraphQL(c *fiber.Ctx) error {
+	body := new(GraphQLBody)
+	if c.Method() == fiber.MethodPost {
+		if err := c.BodyParser(body); err != nil {
+			zap.S().Warnf("GraphQL Handler ERROR: %s", err.Error())
+
+			c.Status(422)
+			return sendGraphQLError(c, "could not parse body")
+		}
+	} else {
+		if err := c.QueryParser(body); err != nil {
+			zap.S().Warnf("GraphQL Handler ERROR: %s", err.Error())
+
+			c.Status(422)
+			return sendGraphQLError(c, "could not parse query parameters")
+		}
+
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &body.Variables); err != nil {
+				c.Status(422)
+				return sendGraphQLError(c, "could not parse variables")
+			}
+		}
+	}
+
+	if body.Query == "" {
+		c.Status(422)
+		return sendGraphQLError(c, "query required")
+	}
+
+	// Limits
+	cost, depth, err := getQueryCost(getSchema(), body.Query, body.Variables, body.OperationName)
+	if err != nil {
+		c.Status(422)
+		return sendGraphQLError(c, err.Error())
+	}
+	if depth > config.Config.GraphQLMaxDepth {
+		c.Status(422)
+		return sendGraphQLError(c, "query depth "+strconv.Itoa(depth)+" is over the max depth of "+strconv.Itoa(config.Config.GraphQLMaxDepth))
+	}
+	if cost > config.Config.GraphQLMaxCost {
+		c.Status(422)
+		return sendGraphQLError(c, "query cost "+strconv.Itoa(cost)+" is over the max cost of "+strconv.Itoa(config.Config.GraphQLMaxCost))
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         *getSchema(),
+		RequestString:  body.Query,
+		VariableValues: body.Variables,
+		OperationName:  body.OperationName,
+		Context:        withMaxPageSize(withLoaders(context.Background()), limits.MaxPageSize(c)),
+	})
+	if len(result.Errors) > 0 {
+		zap.S().Debug("GraphQL Handler ERROR: ", result.Errors)
+	}
+
+	c.Append("X-QUERY-COST", strconv.Itoa(cost))
+
+	response, _ := json.Marshal(result)
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Send(response)
+}
+
+func sendGraphQLError(c *fiber.Ctx, message string) error {
+	response, _ := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{
+			{"message": message},
+		},
+	})
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Send(response)
+}
@@ -0,0 +1,106 @@
+//+build unit
+
+package gql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func init() {
+	config.ReadEnvironment()
+}
+
+func TestHandlerGraphQL(t *testing.T) {
+	assert := assert.New(t)
+
+	app := fiber.New()
+	TransactionsAddHandlers(app)
+
+	path := config.Config.RestPrefix + "/transactions/graphql"
+
+	// Introspection, no database
+	req := httptest.NewRequest("POST", path, strings.NewReader(`{"query": "{ __schema { queryType { name } } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bytes, &body)
+	assert.Equal(nil, err)
+	assert.Equal("Query", body["data"].(map[string]interface{})["__schema"].(map[string]interface{})["queryType"].(map[string]interface{})["name"])
+
+	// GET
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?query="+url.QueryEscape("{ __typename }"), nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	// Missing query
+	resp, err = app.Test(httptest.NewRequest("GET", path, nil))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+
+	// Over max cost
+	query := `{ transactions(first: 100) { edges { node { internal_transactions { from { transactions(first: 100) { edges { node { hash } } } } } } } } }`
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?query="+url.QueryEscape(query), nil))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Contains(string(bytes), "max cost")
+
+	// Mutations are not supported
+	resp, err = app.Test(httptest.NewRequest("GET", path+"?query="+url.QueryEscape("mutation { x }"), nil))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
+
+func TestNewConnection(t *testing.T) {
+	assert := assert.New(t)
+
+	tokenHolders := &[]models.TokenHolder{
+		{HolderAddress: "hx1"},
+		{HolderAddress: "hx2"},
+		{HolderAddress: "hx3"},
+	}
+
+	// Extra row marks a next page
+	c := newConnection(tokenHolders, 10, 2)
+	assert.Equal(2, len(c.Edges))
+	assert.Equal(true, c.PageInfo.HasNextPage)
+	assert.Equal("hx2", c.Edges[1].Node.(*models.TokenHolder).HolderAddress)
+	assert.Equal(c.Edges[1].Cursor, c.PageInfo.EndCursor)
+
+	offset, err := decodeCursor(c.PageInfo.EndCursor)
+	assert.Equal(nil, err)
+	assert.Equal(11, offset)
+
+	// Last page
+	c = newConnection(tokenHolders, 0, 3)
+	assert.Equal(3, len(c.Edges))
+	assert.Equal(false, c.PageInfo.HasNextPage)
+
+	// Empty
+	c = newConnection(&[]models.TokenHolder{}, 0, 3)
+	assert.Equal(0, len(c.Edges))
+	assert.Equal("", c.PageInfo.EndCursor)
+
+	// Invalid cursor
+	_, err = decodeCursor("not a cursor")
+	assert.NotEqual(nil, err)
+}
@@ -0,0 +1,242 @@
+package gql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// batchFunc - load the values of many keys in one query
+// NOTE keys without a value are omitted from the map
+type batchFunc func(keys []string) (map[string]interface{}, error)
+
+// loader - batch the keys loaded by resolvers into one query
+// NOTE thunks are resolved breadth first,
+// every key loaded at a depth is queued before the first thunk of that depth is called
+type loader struct {
+	batch batchFunc
+
+	mutex   sync.Mutex
+	pending []string
+	results map[string]*loaderResult
+}
+
+type loaderResult struct {
+	value  interface{}
+	err    error
+	isDone bool
+}
+
+func newLoader(batch batchFunc) *loader {
+	return &loader{
+		batch:   batch,
+		results: map[string]*loaderResult{},
+	}
+}
+
+// load - queue a key
+// Returns: thunk of the value of the key
+func (l *loader) load(key string) func() (interface{}, error) {
+	l.mutex.Lock()
+	if _, ok := l.results[key]; ok == false {
+		l.results[key] = &loaderResult{}
+		l.pending = append(l.pending, key)
+	}
+	l.mutex.Unlock()
+
+	return func() (interface{}, error) {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		result := l.results[key]
+		if result.isDone == false {
+			l.flush()
+		}
+
+		return result.value, result.err
+	}
+}
+
+// flush - load all pending keys
+// NOTE mutex must be held
+func (l *loader) flush() {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.batch(keys)
+	for _, key := range keys {
+		result := l.results[key]
+		result.value = values[key]
+		result.err = err
+		result.isDone = true
+	}
+}
+
+// loaders - batch loaders of one request
+type loaders struct {
+	transaction          *loader // hash -> *models.TransactionAPIDetail
+	internalTransactions *loader // hash -> []*models.TransactionInternalAPIList
+	tokenTransfers       *loader // transaction hash -> []*models.TokenTransfer
+	tokenContract        *loader // token contract address -> *models.TokenTransfer, latest transfer
+	tokenTransferCount   *loader // token contract address -> uint64
+	tokenHolderCount     *loader // token contract address -> uint64
+	transactionCount     *loader // address -> uint64
+}
+
+type loadersContextKey struct{}
+
+func newLoaders() *loaders {
+	return &loaders{
+		transaction:          newLoader(batchTransactions),
+		internalTransactions: newLoader(batchInternalTransactions),
+		tokenTransfers:       newLoader(batchTokenTransfers),
+		tokenContract:        newLoader(batchTokenContracts),
+		tokenTransferCount:   newLoader(batchTokenTransferCounts),
+		tokenHolderCount:     newLoader(batchTokenHolderCounts),
+		transactionCount:     newLoader(batchTransactionCounts),
+	}
+}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersContextKey{}, newLoaders())
+}
+
+func getLoaders(p graphql.ResolveParams) *loaders {
+	return p.Context.Value(loadersContextKey{}).(*loaders)
+}
+
+func batchTransactions(hashes []string) (map[string]interface{}, error) {
+	transactions, err := crud.GetTransactionModel().SelectManyAPIByHashes(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for i := range *transactions {
+		t := &(*transactions)[i]
+		values[t.Hash] = t
+	}
+
+	return values, nil
+}
+
+func batchInternalTransactions(hashes []string) (map[string]interface{}, error) {
+	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalAPIByHashes(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty lists for hashes without internal transactions
+	grouped := map[string][]*models.TransactionInternalAPIList{}
+	for _, hash := range hashes {
+		grouped[hash] = []*models.TransactionInternalAPIList{}
+	}
+	for i := range *internalTransactions {
+		t := &(*internalTransactions)[i]
+		grouped[t.Hash] = append(grouped[t.Hash], t)
+	}
+
+	values := map[string]interface{}{}
+	for hash, g := range grouped {
+		values[hash] = g
+	}
+
+	return values, nil
+}
+
+func batchTokenTransfers(hashes []string) (map[string]interface{}, error) {
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByTransactionHashes(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty lists for hashes without token transfers
+	grouped := map[string][]*models.TokenTransfer{}
+	for _, hash := range hashes {
+		grouped[hash] = []*models.TokenTransfer{}
+	}
+	for i := range *tokenTransfers {
+		t := &(*tokenTransfers)[i]
+		grouped[t.TransactionHash] = append(grouped[t.TransactionHash], t)
+	}
+
+	values := map[string]interface{}{}
+	for hash, g := range grouped {
+		values[hash] = g
+	}
+
+	return values, nil
+}
+
+func batchTokenContracts(tokenContractAddresses []string) (map[string]interface{}, error) {
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectManyLatestByTokenContractAddresses(tokenContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for i := range *tokenTransfers {
+		t := &(*tokenTransfers)[i]
+		values[t.TokenContractAddress] = t
+	}
+
+	return values, nil
+}
+
+func batchTokenTransferCounts(tokenContractAddresses []string) (map[string]interface{}, error) {
+	counts, err := crud.GetTokenTransferCountByTokenContractModel().SelectManyByTokenContracts(tokenContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for _, address := range tokenContractAddresses {
+		values[address] = uint64(0)
+	}
+	for i := range *counts {
+		c := &(*counts)[i]
+		values[c.TokenContract] = c.Count
+	}
+
+	return values, nil
+}
+
+func batchTokenHolderCounts(tokenContractAddresses []string) (map[string]interface{}, error) {
+	counts, err := crud.GetTokenHolderCountByTokenContractModel().SelectManyByTokenContractAddresses(tokenContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for _, address := range tokenContractAddresses {
+		values[address] = uint64(0)
+	}
+	for i := range *counts {
+		c := &(*counts)[i]
+		values[c.TokenContractAddress] = c.Count
+	}
+
+	return values, nil
+}
+
+func batchTransactionCounts(addresses []string) (map[string]interface{}, error) {
+	counts, err := crud.GetTransactionCountByAddressModel().SelectManyByAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for _, address := range addresses {
+		values[address] = uint64(0)
+	}
+	for i := range *counts {
+		c := &(*counts)[i]
+		values[c.Address] = c.Count
+	}
+
+	return values, nil
+}
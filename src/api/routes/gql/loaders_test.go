@@ -0,0 +1,66 @@
+//+build unit
+
+package gql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	batches := [][]string{}
+	l := newLoader(func(keys []string) (map[string]interface{}, error) {
+		batches = append(batches, keys)
+
+		values := map[string]interface{}{}
+		for _, key := range keys {
+			if key != "missing" {
+				values[key] = "value-" + key
+			}
+		}
+		return values, nil
+	})
+
+	// Keys loaded before the first thunk are batched
+	a := l.load("a")
+	b := l.load("b")
+	a2 := l.load("a")
+	missing := l.load("missing")
+
+	value, err := b()
+	assert.Equal(nil, err)
+	assert.Equal("value-b", value)
+
+	value, _ = a()
+	assert.Equal("value-a", value)
+	value, _ = a2()
+	assert.Equal("value-a", value)
+	value, _ = missing()
+	assert.Equal(nil, value)
+
+	assert.Equal([][]string{{"a", "b", "missing"}}, batches)
+
+	// Loaded keys are cached
+	c := l.load("c")
+	a3 := l.load("a")
+	value, _ = a3()
+	assert.Equal("value-a", value)
+	value, _ = c()
+	assert.Equal("value-c", value)
+	assert.Equal([][]string{{"a", "b", "missing"}, {"c"}}, batches)
+
+	// Errors are returned for every key of the batch
+	l = newLoader(func(keys []string) (map[string]interface{}, error) {
+		return nil, errors.New("batch failed")
+	})
+	d := l.load("d")
+	e := l.load("e")
+	_, err = d()
+	assert.NotEqual(nil, err)
+	_, err = e()
+	assert.NotEqual(nil, err)
+}
@@ -0,0 +1,692 @@
+package gql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// tokenContract - source of the TokenContract type
+type tokenContract struct {
+	Address string `json:"address"`
+}
+
+// address - source of the Address type
+type address struct {
+	Address string `json:"address"`
+}
+
+// connection - page of nodes
+type connection struct {
+	Edges    []*edge   `json:"edges"`
+	PageInfo *pageInfo `json:"page_info"`
+}
+
+type edge struct {
+	Cursor string      `json:"cursor"`
+	Node   interface{} `json:"node"`
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"has_next_page"`
+	EndCursor   string `json:"end_cursor"`
+}
+
+// newConnection - page of the nodes in a slice starting at offset
+// NOTE rows holds up to first+1 rows, the extra row marks a next page
+func newConnection(rows interface{}, offset int, first int) *connection {
+	r := reflect.ValueOf(rows)
+	if r.Kind() == reflect.Ptr {
+		r = r.Elem()
+	}
+
+	c := &connection{
+		Edges:    []*edge{},
+		PageInfo: &pageInfo{},
+	}
+	for i := 0; i < r.Len(); i++ {
+		if i == first {
+			c.PageInfo.HasNextPage = true
+			break
+		}
+
+		c.Edges = append(c.Edges, &edge{
+			Cursor: encodeCursor(offset + i),
+			Node:   r.Index(i).Addr().Interface(),
+		})
+	}
+	if len(c.Edges) > 0 {
+		c.PageInfo.EndCursor = c.Edges[len(c.Edges)-1].Cursor
+	}
+
+	return c
+}
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errors.New("invalid cursor")
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(decoded), "offset:"))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid cursor")
+	}
+
+	return offset, nil
+}
+
+// pageArgs - first and after arguments of connections
+var pageArgs = graphql.FieldConfigArgument{
+	"first": &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: 25,
+	},
+	"after": &graphql.ArgumentConfig{
+		Type: graphql.String,
+	},
+}
+
+// maxPageSizeContextKey - max first argument of the request, set by its api key tier
+type maxPageSizeContextKey struct{}
+
+func withMaxPageSize(ctx context.Context, maxPageSize int) context.Context {
+	return context.WithValue(ctx, maxPageSizeContextKey{}, maxPageSize)
+}
+
+func getMaxPageSize(p graphql.ResolveParams) int {
+	if p.Context != nil {
+		if maxPageSize, ok := p.Context.Value(maxPageSizeContextKey{}).(int); ok {
+			return maxPageSize
+		}
+	}
+
+	return config.Config.MaxPageSize
+}
+
+// parsePageArgs - check the first and after arguments
+// Returns: offset, first, error (if present)
+func parsePageArgs(p graphql.ResolveParams) (int, int, error) {
+	first, _ := p.Args["first"].(int)
+	maxPageSize := getMaxPageSize(p)
+	if first < 1 || first > maxPageSize {
+		return 0, 0, errors.New("first must be greater than 0 and less than " + strconv.Itoa(maxPageSize+1))
+	}
+
+	offset := 0
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		cursorOffset, err := decodeCursor(after)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		offset = cursorOffset + 1
+	}
+	if offset > config.Config.MaxPageSkip {
+		return 0, 0, errors.New("invalid after")
+	}
+
+	return offset, first, nil
+}
+
+func newConnectionType(name string, nodeType *graphql.Object) *graphql.Object {
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(nodeType)},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Connection",
+		Fields: graphql.Fields{
+			"edges":     &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edgeType)))},
+			"page_info": &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		},
+	})
+}
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"has_next_page": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"end_cursor":    &graphql.Field{Type: graphql.String},
+	},
+})
+
+// Sources of fields shared by more than one model
+type hashSource interface{ GetHash() string }
+type fromAddressSource interface{ GetFromAddress() string }
+type toAddressSource interface{ GetToAddress() string }
+
+func resolveFromAddress(p graphql.ResolveParams) (interface{}, error) {
+	if s, ok := p.Source.(fromAddressSource); ok && s.GetFromAddress() != "" && s.GetFromAddress() != "None" {
+		return &address{Address: s.GetFromAddress()}, nil
+	}
+	return nil, nil
+}
+
+func resolveToAddress(p graphql.ResolveParams) (interface{}, error) {
+	if s, ok := p.Source.(toAddressSource); ok && s.GetToAddress() != "" && s.GetToAddress() != "None" {
+		return &address{Address: s.GetToAddress()}, nil
+	}
+	return nil, nil
+}
+
+// resolveTransactionDetail - resolve fields only selected by transaction details
+// NOTE list rows load their details in one batch
+func resolveTransactionDetail(p graphql.ResolveParams) (interface{}, error) {
+	transactionList, ok := p.Source.(*models.TransactionAPIList)
+	if ok == false {
+		return graphql.DefaultResolveFn(p)
+	}
+
+	thunk := getLoaders(p).transaction.load(transactionList.Hash)
+	return func() (interface{}, error) {
+		transactionDetail, err := thunk()
+		if err != nil || transactionDetail == nil {
+			return nil, err
+		}
+
+		p.Source = transactionDetail
+		return graphql.DefaultResolveFn(p)
+	}, nil
+}
+
+var transactionType *graphql.Object
+var internalTransactionType *graphql.Object
+var tokenTransferType *graphql.Object
+var tokenHolderType *graphql.Object
+var tokenContractType *graphql.Object
+var addressType *graphql.Object
+
+var transactionConnectionType *graphql.Object
+var internalTransactionConnectionType *graphql.Object
+var tokenTransferConnectionType *graphql.Object
+var tokenHolderConnectionType *graphql.Object
+
+func init() {
+	transactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Transaction",
+		Description: "Transaction, block timestamps are unix micro seconds",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"hash":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"type":            &graphql.Field{Type: graphql.String},
+				"block_number":    &graphql.Field{Type: graphql.Int},
+				"block_timestamp": &graphql.Field{Type: graphql.Float},
+				"from_address":    &graphql.Field{Type: graphql.String},
+				"to_address":      &graphql.Field{Type: graphql.String},
+				"value":           &graphql.Field{Type: graphql.String},
+				"value_decimal":   &graphql.Field{Type: graphql.Float},
+				"transaction_fee": &graphql.Field{Type: graphql.String},
+				"receipt_status":  &graphql.Field{Type: graphql.Int},
+				"method":          &graphql.Field{Type: graphql.String},
+				"data":            &graphql.Field{Type: graphql.String},
+
+				// Details
+				"version":                      &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"step_limit":                   &graphql.Field{Type: graphql.Float, Resolve: resolveTransactionDetail},
+				"timestamp":                    &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"nid":                          &graphql.Field{Type: graphql.Int, Resolve: resolveTransactionDetail},
+				"nonce":                        &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"transaction_index":            &graphql.Field{Type: graphql.Int, Resolve: resolveTransactionDetail},
+				"block_hash":                   &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"signature":                    &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"data_type":                    &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"receipt_cumulative_step_used": &graphql.Field{Type: graphql.Float, Resolve: resolveTransactionDetail},
+				"receipt_step_used":            &graphql.Field{Type: graphql.Float, Resolve: resolveTransactionDetail},
+				"receipt_step_price":           &graphql.Field{Type: graphql.Float, Resolve: resolveTransactionDetail},
+				"receipt_score_address":        &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+				"receipt_logs":                 &graphql.Field{Type: graphql.String, Resolve: resolveTransactionDetail},
+
+				// Nested
+				"from": &graphql.Field{Type: addressType, Resolve: resolveFromAddress},
+				"to":   &graphql.Field{Type: addressType, Resolve: resolveToAddress},
+				"internal_transactions": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(internalTransactionType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).internalTransactions.load(p.Source.(hashSource).GetHash()), nil
+					},
+				},
+				"token_transfers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tokenTransferType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).tokenTransfers.load(p.Source.(hashSource).GetHash()), nil
+					},
+				},
+			}
+		}),
+	})
+
+	internalTransactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "InternalTransaction",
+		Description: "Internal transaction, block timestamps are unix micro seconds",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"hash":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"type":              &graphql.Field{Type: graphql.String},
+				"block_number":      &graphql.Field{Type: graphql.Int},
+				"block_timestamp":   &graphql.Field{Type: graphql.Float},
+				"block_hash":        &graphql.Field{Type: graphql.String},
+				"transaction_index": &graphql.Field{Type: graphql.Int},
+				"from_address":      &graphql.Field{Type: graphql.String},
+				"to_address":        &graphql.Field{Type: graphql.String},
+				"value":             &graphql.Field{Type: graphql.String},
+				"data":              &graphql.Field{Type: graphql.String},
+				"receipt_status":    &graphql.Field{Type: graphql.Int},
+
+				// Nested
+				"from": &graphql.Field{Type: addressType, Resolve: resolveFromAddress},
+				"to":   &graphql.Field{Type: addressType, Resolve: resolveToAddress},
+				"transaction": &graphql.Field{
+					Type: transactionType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).transaction.load(p.Source.(hashSource).GetHash()), nil
+					},
+				},
+			}
+		}),
+	})
+
+	tokenTransferType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "TokenTransfer",
+		Description: "Token transfer, block timestamps are unix micro seconds",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"transaction_hash":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"log_index":              &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"token_contract_address": &graphql.Field{Type: graphql.String},
+				"token_contract_name":    &graphql.Field{Type: graphql.String},
+				"token_contract_symbol":  &graphql.Field{Type: graphql.String},
+				"from_address":           &graphql.Field{Type: graphql.String},
+				"to_address":             &graphql.Field{Type: graphql.String},
+				"value":                  &graphql.Field{Type: graphql.String},
+				"value_decimal":          &graphql.Field{Type: graphql.Float},
+				"block_number":           &graphql.Field{Type: graphql.Int},
+				"block_timestamp":        &graphql.Field{Type: graphql.Float},
+				"transaction_fee":        &graphql.Field{Type: graphql.String},
+
+				// Nested
+				"from": &graphql.Field{Type: addressType, Resolve: resolveFromAddress},
+				"to":   &graphql.Field{Type: addressType, Resolve: resolveToAddress},
+				"transaction": &graphql.Field{
+					Type: transactionType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).transaction.load(p.Source.(*models.TokenTransfer).TransactionHash), nil
+					},
+				},
+				"token_contract": &graphql.Field{
+					Type: tokenContractType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return &tokenContract{Address: p.Source.(*models.TokenTransfer).TokenContractAddress}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	tokenHolderType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TokenHolder",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"token_contract_address": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"holder_address":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"value":                  &graphql.Field{Type: graphql.String},
+				"value_decimal":          &graphql.Field{Type: graphql.Float},
+
+				// Nested
+				"holder": &graphql.Field{
+					Type: addressType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return &address{Address: p.Source.(*models.TokenHolder).HolderAddress}, nil
+					},
+				},
+				"token_contract": &graphql.Field{
+					Type: tokenContractType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return &tokenContract{Address: p.Source.(*models.TokenHolder).TokenContractAddress}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	tokenContractType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TokenContract",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"address": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"name": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						thunk := getLoaders(p).tokenContract.load(p.Source.(*tokenContract).Address)
+						return func() (interface{}, error) {
+							t, err := thunk()
+							if err != nil || t == nil {
+								return nil, err
+							}
+							return t.(*models.TokenTransfer).TokenContractName, nil
+						}, nil
+					},
+				},
+				"symbol": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						thunk := getLoaders(p).tokenContract.load(p.Source.(*tokenContract).Address)
+						return func() (interface{}, error) {
+							t, err := thunk()
+							if err != nil || t == nil {
+								return nil, err
+							}
+							return t.(*models.TokenTransfer).TokenContractSymbol, nil
+						}, nil
+					},
+				},
+				"transfer_count": &graphql.Field{
+					Type: graphql.Int,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).tokenTransferCount.load(p.Source.(*tokenContract).Address), nil
+					},
+				},
+				"holder_count": &graphql.Field{
+					Type: graphql.Int,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).tokenHolderCount.load(p.Source.(*tokenContract).Address), nil
+					},
+				},
+				"holders": &graphql.Field{
+					Type:        graphql.NewNonNull(tokenHolderConnectionType),
+					Description: "Holders, largest balances first",
+					Args:        pageArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, first, err := parsePageArgs(p)
+						if err != nil {
+							return nil, err
+						}
+
+						tokenHolders, err := crud.GetTokenHolderModel().SelectManyByTokenContractAddress(
+							first+1,
+							offset,
+							p.Source.(*tokenContract).Address,
+						)
+						if err != nil {
+							return nil, err
+						}
+
+						return newConnection(tokenHolders, offset, first), nil
+					},
+				},
+				"transfers": &graphql.Field{
+					Type:        graphql.NewNonNull(tokenTransferConnectionType),
+					Description: "Transfers, latest first",
+					Args:        pageArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, first, err := parsePageArgs(p)
+						if err != nil {
+							return nil, err
+						}
+
+						tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByTokenContractAddress(
+							first+1,
+							offset,
+							p.Source.(*tokenContract).Address,
+							0,
+							0,
+						)
+						if err != nil {
+							return nil, err
+						}
+
+						return newConnection(tokenTransfers, offset, first), nil
+					},
+				},
+			}
+		}),
+	})
+
+	addressType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Address",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"address": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"transaction_count": &graphql.Field{
+					Type: graphql.Int,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getLoaders(p).transactionCount.load(p.Source.(*address).Address), nil
+					},
+				},
+				"transactions": &graphql.Field{
+					Type:        graphql.NewNonNull(transactionConnectionType),
+					Description: "Transactions from or to the address, latest first",
+					Args:        pageArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, first, err := parsePageArgs(p)
+						if err != nil {
+							return nil, err
+						}
+
+						transactions, err := crud.GetTransactionModel().SelectManyByAddressAPI(
+							first+1,
+							offset,
+							p.Source.(*address).Address,
+							0,
+							0,
+						)
+						if err != nil {
+							return nil, err
+						}
+
+						return newConnection(transactions, offset, first), nil
+					},
+				},
+				"internal_transactions": &graphql.Field{
+					Type:        graphql.NewNonNull(internalTransactionConnectionType),
+					Description: "Internal transactions from or to the address, latest first",
+					Args:        pageArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, first, err := parsePageArgs(p)
+						if err != nil {
+							return nil, err
+						}
+
+						internalTransactions, err := crud.GetTransactionModel().SelectManyInternalByAddressAPI(
+							first+1,
+							offset,
+							p.Source.(*address).Address,
+							0,
+							0,
+						)
+						if err != nil {
+							return nil, err
+						}
+
+						return newConnection(internalTransactions, offset, first), nil
+					},
+				},
+				"token_transfers": &graphql.Field{
+					Type:        graphql.NewNonNull(tokenTransferConnectionType),
+					Description: "Token transfers from or to the address, latest first",
+					Args:        pageArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, first, err := parsePageArgs(p)
+						if err != nil {
+							return nil, err
+						}
+
+						tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByAddress(
+							first+1,
+							offset,
+							p.Source.(*address).Address,
+							0,
+							0,
+						)
+						if err != nil {
+							return nil, err
+						}
+
+						return newConnection(tokenTransfers, offset, first), nil
+					},
+				},
+			}
+		}),
+	})
+
+	transactionConnectionType = newConnectionType("Transaction", transactionType)
+	internalTransactionConnectionType = newConnectionType("InternalTransaction", internalTransactionType)
+	tokenTransferConnectionType = newConnectionType("TokenTransfer", tokenTransferType)
+	tokenHolderConnectionType = newConnectionType("TokenHolder", tokenHolderType)
+}
+
+// newSchema - schema of the graphql endpoint
+func newSchema() (graphql.Schema, error) {
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"transaction": &graphql.Field{
+				Type: transactionType,
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return getLoaders(p).transaction.load(p.Args["hash"].(string)), nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type:        graphql.NewNonNull(transactionConnectionType),
+				Description: "Transactions, latest first",
+				Args: mergeArgs(pageArgs, graphql.FieldConfigArgument{
+					"from":         &graphql.ArgumentConfig{Type: graphql.String},
+					"to":           &graphql.ArgumentConfig{Type: graphql.String},
+					"block_number": &graphql.ArgumentConfig{Type: graphql.Int},
+					"method":       &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, first, err := parsePageArgs(p)
+					if err != nil {
+						return nil, err
+					}
+
+					filters := &crud.TransactionFilters{
+						Type: "transaction",
+					}
+					if from, ok := p.Args["from"].(string); ok && from != "" {
+						filters.From = []string{from}
+					}
+					if to, ok := p.Args["to"].(string); ok && to != "" {
+						filters.To = []string{to}
+					}
+					if blockNumber, ok := p.Args["block_number"].(int); ok {
+						filters.BlockNumber = blockNumber
+					}
+					if method, ok := p.Args["method"].(string); ok && method != "" {
+						filters.Method = []string{method}
+					}
+
+					transactions, err := crud.GetTransactionModel().SelectManyAPI(first+1, offset, filters)
+					if err != nil {
+						return nil, err
+					}
+
+					return newConnection(transactions, offset, first), nil
+				},
+			},
+			"internal_transactions": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(internalTransactionType))),
+				Args: graphql.FieldConfigArgument{
+					"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return getLoaders(p).internalTransactions.load(p.Args["hash"].(string)), nil
+				},
+			},
+			"token_transfers": &graphql.Field{
+				Type:        graphql.NewNonNull(tokenTransferConnectionType),
+				Description: "Token transfers, latest first",
+				Args: mergeArgs(pageArgs, graphql.FieldConfigArgument{
+					"from":                   &graphql.ArgumentConfig{Type: graphql.String},
+					"to":                     &graphql.ArgumentConfig{Type: graphql.String},
+					"transaction_hash":       &graphql.ArgumentConfig{Type: graphql.String},
+					"token_contract_address": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, first, err := parsePageArgs(p)
+					if err != nil {
+						return nil, err
+					}
+
+					from, _ := p.Args["from"].(string)
+					to, _ := p.Args["to"].(string)
+					transactionHash, _ := p.Args["transaction_hash"].(string)
+					tokenContractAddress, _ := p.Args["token_contract_address"].(string)
+
+					tokenTransfers, err := crud.GetTokenTransferModel().SelectMany(
+						first+1,
+						offset,
+						from,
+						to,
+						0,
+						0,
+						0,
+						0,
+						0,
+						transactionHash,
+						tokenContractAddress,
+					)
+					if err != nil {
+						return nil, err
+					}
+
+					return newConnection(tokenTransfers, offset, first), nil
+				},
+			},
+			"token_contract": &graphql.Field{
+				Type: tokenContractType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return &tokenContract{Address: p.Args["address"].(string)}, nil
+				},
+			},
+			"address": &graphql.Field{
+				Type: addressType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return &address{Address: p.Args["address"].(string)}, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+}
+
+func mergeArgs(args ...graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	merged := graphql.FieldConfigArgument{}
+	for _, a := range args {
+		for name, arg := range a {
+			merged[name] = arg
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,347 @@
+package limits
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
+)
+
+const (
+	HeaderAPIKey             = "X-API-KEY"
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+
+	localsKey = "limits"
+
+	// Cached key hashes, the least recently used is evicted past it
+	apiKeyCacheSize = 10000
+)
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// Limits - limits of a request, set by the tier of its api key
+type Limits struct {
+	Tier           string
+	RateLimit      float64
+	RateLimitBurst int64
+	MaxPageSize    int
+	MaxExportSize  int // 0 for no limit
+}
+
+func anonymousLimits() *Limits {
+	return &Limits{
+		Tier:           "anonymous",
+		RateLimit:      config.Config.RateLimitAnonymousRate,
+		RateLimitBurst: config.Config.RateLimitAnonymousBurst,
+		MaxPageSize:    config.Config.MaxPageSize,
+		MaxExportSize:  config.Config.MaxExportSize,
+	}
+}
+
+// New - middleware checking the api key and rate limit of a request
+// NOTE keys are read from the X-API-KEY header or the api_key query param
+func New() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		apiKey := c.Get(HeaderAPIKey)
+		if apiKey == "" {
+			apiKey = c.Query("api_key")
+		}
+
+		limits, err := check(apiKey, c.IP(), func(limits *Limits, bucket string) bool {
+			return takeToken(c, limits, bucket)
+		})
+		if errors.Is(err, ErrRateLimited) {
+			return apierrors.Send(c, apierrors.RateLimited("rate limit exceeded"))
+		} else if errors.Is(err, ErrInvalidAPIKey) {
+			return apierrors.Send(c, apierrors.Unauthorized("invalid api key"))
+		} else if err != nil {
+			zap.S().Warn("Limits ERROR: ", err.Error())
+			return apierrors.Send(c, apierrors.Internal("could not retrieve api key"))
+		}
+
+		c.Locals(localsKey, limits)
+
+		return c.Next()
+	}
+}
+
+// Check - limits of a request of another server, ex grpc, checked like New
+// NOTE rate limit headers are not sent
+// Returns: limits, error: ErrInvalidAPIKey, ErrRateLimited or a postgres error
+func Check(apiKey string, ip string) (*Limits, error) {
+	return check(apiKey, ip, func(limits *Limits, bucket string) bool {
+		rateLimit := takeBucketToken(limits, bucket)
+		if rateLimit == nil {
+			return true
+		}
+
+		return rateLimit.Allowed
+	})
+}
+
+// check - limits of a request, take takes a token from a rate limit bucket
+func check(apiKey string, ip string, take func(limits *Limits, bucket string) bool) (*Limits, error) {
+
+	limits := anonymousLimits()
+	bucket := "ip_" + ip
+
+	if apiKey != "" {
+		keyHash := HashAPIKey(apiKey)
+
+		// NOTE keys read from postgres and invalid keys are charged to the ip bucket
+		// Guessing keys is rate limited like anonymous requests
+		isCharged := false
+		if isAPIKeyCached(keyHash) == false {
+			if take(limits, bucket) == false {
+				return nil, ErrRateLimited
+			}
+			isCharged = true
+		}
+
+		keyLimits, err := getAPIKeyLimits(keyHash)
+		if errors.Is(err, ErrInvalidAPIKey) {
+			if isCharged == false && take(limits, bucket) == false {
+				return nil, ErrRateLimited
+			}
+
+			return nil, err
+		} else if err != nil {
+			return nil, err
+		}
+
+		limits = keyLimits
+		bucket = "key_" + keyHash
+	}
+
+	if take(limits, bucket) == false {
+		return nil, ErrRateLimited
+	}
+
+	return limits, nil
+}
+
+// takeToken - take a token from a rate limit bucket and set the rate limit headers
+// Returns: false if the bucket is empty
+func takeToken(c *fiber.Ctx, limits *Limits, bucket string) bool {
+	rateLimit := takeBucketToken(limits, bucket)
+	if rateLimit == nil {
+		return true
+	}
+
+	setRateLimitHeaders(c, limits, rateLimit)
+
+	return rateLimit.Allowed
+}
+
+// takeBucketToken - take a token from a rate limit bucket
+// Returns: rate limit of the bucket, nil if not limited
+func takeBucketToken(limits *Limits, bucket string) *redis.RateLimit {
+	if config.Config.RateLimitEnabled == false {
+		return nil
+	}
+
+	rateLimit, err := redis.GetRedisClient().TakeToken(
+		config.Config.RedisKeyPrefix+"rate_limit_"+bucket,
+		limits.RateLimit,
+		limits.RateLimitBurst,
+	)
+	if err != nil {
+		// NOTE requests are allowed while redis is unavailable
+		zap.S().Warn("Limits ERROR: ", err.Error())
+		return nil
+	}
+
+	return rateLimit
+}
+
+// MaxPageSize - max limit param of the request
+func MaxPageSize(c *fiber.Ctx) int {
+	limits, ok := c.Locals(localsKey).(*Limits)
+	if ok == false {
+		return config.Config.MaxPageSize
+	}
+
+	return limits.MaxPageSize
+}
+
+// MaxExportSize - max rows of an export of the request, 0 for no limit
+func MaxExportSize(c *fiber.Ctx) int {
+	limits, ok := c.Locals(localsKey).(*Limits)
+	if ok == false {
+		return config.Config.MaxExportSize
+	}
+
+	return limits.MaxExportSize
+}
+
+// HashAPIKey - sha256 hex of a key, as stored in the api_keys table
+func HashAPIKey(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func setRateLimitHeaders(c *fiber.Ctx, limits *Limits, rateLimit *redis.RateLimit) {
+	c.Set(HeaderRateLimitLimit, strconv.FormatInt(limits.RateLimitBurst, 10))
+	c.Set(HeaderRateLimitRemaining, strconv.FormatInt(rateLimit.Remaining, 10))
+	c.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(rateLimit.Reset)))
+
+	if rateLimit.Allowed == false {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(rateLimit.RetryAfter)))
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+///////////////////
+// API key cache //
+///////////////////
+
+// apiKeyCacheEntry - limits of a key hash, nil for invalid keys
+type apiKeyCacheEntry struct {
+	keyHash   string
+	limits    *Limits
+	expiresAt time.Time
+}
+
+// NOTE the least recently used key is evicted once the cache is full
+var apiKeyCache = map[string]*list.Element{}
+var apiKeyCacheList = list.New()
+var apiKeyCacheMutex sync.Mutex
+
+// isAPIKeyCached - if a key hash is cached and not expired
+func isAPIKeyCached(keyHash string) bool {
+	return getCachedAPIKey(keyHash) != nil
+}
+
+// getCachedAPIKey - cache entry of a key hash, nil if missing or expired
+func getCachedAPIKey(keyHash string) *apiKeyCacheEntry {
+	apiKeyCacheMutex.Lock()
+	defer apiKeyCacheMutex.Unlock()
+
+	element, ok := apiKeyCache[keyHash]
+	if ok == false {
+		return nil
+	}
+
+	entry := element.Value.(*apiKeyCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		return nil
+	}
+
+	apiKeyCacheList.MoveToFront(element)
+
+	return entry
+}
+
+// setCachedAPIKey - cache an entry, evicting the least recently used one if full
+func setCachedAPIKey(entry *apiKeyCacheEntry) {
+	apiKeyCacheMutex.Lock()
+	defer apiKeyCacheMutex.Unlock()
+
+	element, ok := apiKeyCache[entry.keyHash]
+	if ok {
+		element.Value = entry
+		apiKeyCacheList.MoveToFront(element)
+		return
+	}
+
+	for apiKeyCacheList.Len() >= apiKeyCacheSize {
+		oldest := apiKeyCacheList.Back()
+		apiKeyCacheList.Remove(oldest)
+		delete(apiKeyCache, oldest.Value.(*apiKeyCacheEntry).keyHash)
+	}
+
+	apiKeyCache[entry.keyHash] = apiKeyCacheList.PushFront(entry)
+}
+
+// getAPIKeyLimits - limits of the tier of a key
+// NOTE keys are cached for API_KEY_CACHE_SECONDS, including invalid keys
+func getAPIKeyLimits(keyHash string) (*Limits, error) {
+	entry := getCachedAPIKey(keyHash)
+
+	if entry == nil {
+		limits, err := selectAPIKeyLimits(keyHash)
+		if err != nil && errors.Is(err, ErrInvalidAPIKey) == false {
+			return nil, err
+		}
+
+		entry = &apiKeyCacheEntry{
+			keyHash:   keyHash,
+			limits:    limits,
+			expiresAt: time.Now().Add(time.Duration(config.Config.ApiKeyCacheSeconds) * time.Second),
+		}
+
+		setCachedAPIKey(entry)
+	}
+
+	if entry.limits == nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return entry.limits, nil
+}
+
+// selectAPIKeyLimits - read a key and its tier from postgres
+var selectAPIKeyLimits = func(keyHash string) (*Limits, error) {
+
+	apiKey, err := crud.GetApiKeyModel().SelectOne(keyHash)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidAPIKey
+	} else if err != nil {
+		return nil, err
+	}
+	if apiKey.IsDisabled {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiTier, err := crud.GetApiKeyModel().SelectOneTier(apiKey.Tier)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("no tier found for api key tier " + apiKey.Tier)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return tierLimits(apiTier), nil
+}
+
+// tierLimits - limits of an api tier
+// NOTE page and export sizes of 0 default to MAX_PAGE_SIZE and MAX_EXPORT_SIZE
+func tierLimits(apiTier *models.ApiTier) *Limits {
+	limits := &Limits{
+		Tier:           apiTier.Name,
+		RateLimit:      apiTier.RateLimit,
+		RateLimitBurst: int64(apiTier.RateLimitBurst),
+		MaxPageSize:    int(apiTier.MaxPageSize),
+		MaxExportSize:  int(apiTier.MaxExportSize),
+	}
+
+	if limits.MaxPageSize == 0 {
+		limits.MaxPageSize = config.Config.MaxPageSize
+	}
+	if limits.MaxExportSize == 0 {
+		limits.MaxExportSize = config.Config.MaxExportSize
+	}
+
+	return limits
+}
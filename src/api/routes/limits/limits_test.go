@@ -0,0 +1,182 @@
+//+build unit
+
+package limits
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
+)
+
+func init() {
+	config.ReadEnvironment()
+}
+
+func TestHashAPIKey(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", HashAPIKey("foo"))
+}
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.RateLimitEnabled = false
+	defer func() { config.Config.RateLimitEnabled = true }()
+
+	selectAPIKeyLimits = func(keyHash string) (*Limits, error) {
+		if keyHash != HashAPIKey("valid") {
+			return nil, ErrInvalidAPIKey
+		}
+
+		return &Limits{Tier: "pro", RateLimit: 50, RateLimitBurst: 100, MaxPageSize: 1000, MaxExportSize: 0}, nil
+	}
+
+	app := fiber.New()
+	app.Use(New())
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString(strconv.Itoa(MaxPageSize(c)) + "," + strconv.Itoa(MaxExportSize(c)))
+	})
+
+	// Anonymous
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal(strconv.Itoa(config.Config.MaxPageSize)+","+strconv.Itoa(config.Config.MaxExportSize), string(bytes))
+
+	// Header key
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(HeaderAPIKey, "valid")
+	resp, err = app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal("1000,0", string(bytes))
+
+	// Query key
+	resp, err = app.Test(httptest.NewRequest("GET", "/?api_key=valid", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	// Invalid key
+	resp, err = app.Test(httptest.NewRequest("GET", "/?api_key=invalid", nil))
+	assert.Equal(nil, err)
+	assert.Equal(401, resp.StatusCode)
+}
+
+func TestGetAPIKeyLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	selectCount := 0
+	selectAPIKeyLimits = func(keyHash string) (*Limits, error) {
+		selectCount++
+		if keyHash == "invalid" {
+			return nil, ErrInvalidAPIKey
+		}
+
+		return &Limits{Tier: "pro"}, nil
+	}
+
+	// Cached
+	limits, err := getAPIKeyLimits("cached")
+	assert.Equal(nil, err)
+	assert.Equal("pro", limits.Tier)
+
+	limits, err = getAPIKeyLimits("cached")
+	assert.Equal(nil, err)
+	assert.Equal("pro", limits.Tier)
+	assert.Equal(1, selectCount)
+
+	// Invalid keys are cached
+	_, err = getAPIKeyLimits("invalid")
+	assert.Equal(ErrInvalidAPIKey, err)
+
+	_, err = getAPIKeyLimits("invalid")
+	assert.Equal(ErrInvalidAPIKey, err)
+	assert.Equal(2, selectCount)
+
+	// Least recently used keys are evicted
+	for i := 0; i < apiKeyCacheSize; i++ {
+		_, err = getAPIKeyLimits("key_" + strconv.Itoa(i))
+		assert.Equal(nil, err)
+
+		if i == 0 {
+			// Used again
+			_, err = getAPIKeyLimits("cached")
+			assert.Equal(nil, err)
+		}
+	}
+	assert.Equal(apiKeyCacheSize, apiKeyCacheList.Len())
+	assert.Equal(true, isAPIKeyCached("cached"))
+	assert.Equal(false, isAPIKeyCached("invalid"))
+	assert.Equal(false, isAPIKeyCached("key_0"))
+	assert.Equal(true, isAPIKeyCached("key_1"))
+}
+
+func TestTierLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.MaxPageSize = 100
+	config.Config.MaxExportSize = 100000
+
+	limits := tierLimits(&models.ApiTier{
+		Name:           "pro",
+		RateLimit:      50,
+		RateLimitBurst: 100,
+		MaxPageSize:    500,
+		MaxExportSize:  1000000,
+	})
+	assert.Equal("pro", limits.Tier)
+	assert.Equal(float64(50), limits.RateLimit)
+	assert.Equal(int64(100), limits.RateLimitBurst)
+	assert.Equal(500, limits.MaxPageSize)
+	assert.Equal(1000000, limits.MaxExportSize)
+
+	// Sizes of 0 default to the config
+	limits = tierLimits(&models.ApiTier{Name: "basic"})
+	assert.Equal(100, limits.MaxPageSize)
+	assert.Equal(100000, limits.MaxExportSize)
+}
+
+func TestSetRateLimitHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	limits := &Limits{RateLimit: 5, RateLimitBurst: 20}
+
+	app := fiber.New()
+	app.Get("/allowed", func(c *fiber.Ctx) error {
+		setRateLimitHeaders(c, limits, &redis.RateLimit{Allowed: true, Remaining: 19, Reset: 200 * time.Millisecond})
+		return nil
+	})
+	app.Get("/limited", func(c *fiber.Ctx) error {
+		setRateLimitHeaders(c, limits, &redis.RateLimit{Allowed: false, Remaining: 0, RetryAfter: 1500 * time.Millisecond, Reset: 4 * time.Second})
+		return nil
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/allowed", nil))
+	assert.Equal(nil, err)
+	assert.Equal("20", resp.Header.Get(HeaderRateLimitLimit))
+	assert.Equal("19", resp.Header.Get(HeaderRateLimitRemaining))
+	assert.Equal("1", resp.Header.Get(HeaderRateLimitReset))
+	assert.Equal("", resp.Header.Get(fiber.HeaderRetryAfter))
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/limited", nil))
+	assert.Equal(nil, err)
+	assert.Equal("0", resp.Header.Get(HeaderRateLimitRemaining))
+	assert.Equal("4", resp.Header.Get(HeaderRateLimitReset))
+	assert.Equal("2", resp.Header.Get(fiber.HeaderRetryAfter))
+}
@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"strconv"
+
+	fiber "github.com/gofiber/fiber/v2"
+)
+
+// setTotalCount - set the X-TOTAL-COUNT header
+// NOTE estimated counts are flagged with X-TOTAL-COUNT-ESTIMATED
+func setTotalCount(c *fiber.Ctx, count int64, isEstimated bool) {
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if isEstimated {
+		c.Append("X-TOTAL-COUNT-ESTIMATED", "true")
+	}
+}
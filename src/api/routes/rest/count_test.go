@@ -0,0 +1,37 @@
+//+build unit
+
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTotalCount(t *testing.T) {
+	assert := assert.New(t)
+
+	app := fiber.New()
+	app.Get("/exact", func(c *fiber.Ctx) error {
+		setTotalCount(c, 12, false)
+		return c.SendString("[]")
+	})
+	app.Get("/estimated", func(c *fiber.Ctx) error {
+		setTotalCount(c, 50000, true)
+		return c.SendString("[]")
+	})
+
+	// Exact
+	resp, err := app.Test(httptest.NewRequest("GET", "/exact", nil))
+	assert.Equal(nil, err)
+	assert.Equal("12", resp.Header.Get("X-TOTAL-COUNT"))
+	assert.Equal("", resp.Header.Get("X-TOTAL-COUNT-ESTIMATED"))
+
+	// Estimated
+	resp, err = app.Test(httptest.NewRequest("GET", "/estimated", nil))
+	assert.Equal(nil, err)
+	assert.Equal("50000", resp.Header.Get("X-TOTAL-COUNT"))
+	assert.Equal("true", resp.Header.Get("X-TOTAL-COUNT-ESTIMATED"))
+}
@@ -0,0 +1,161 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+const (
+	expandInternal         = "internal"
+	expandTokenTransfers   = "token_transfers"
+	expandLogs             = "logs"
+	expandContractCreation = "contract_creation"
+)
+
+// TransactionLog - parsed receipt log
+type TransactionLog struct {
+	LogIndex     int           `json:"log_index"`
+	ScoreAddress string        `json:"score_address"`
+	Method       string        `json:"method"`
+	Indexed      []string      `json:"indexed"`
+	Data         []interface{} `json:"data"`
+}
+
+// ContractCreation - accept or reject status of a contract deployment
+type ContractCreation struct {
+	*models.TransactionCreateScore
+
+	// pending, accepted, or rejected
+	Status string `json:"status"`
+}
+
+// parseExpand - parse and check the comma separated expand param
+// Returns: set of expanded rows, error (if present)
+func parseExpand(expand string) (map[string]bool, error) {
+	expanded := map[string]bool{}
+
+	for _, e := range splitList(expand) {
+		switch e {
+		case expandInternal, expandTokenTransfers, expandLogs, expandContractCreation:
+			expanded[e] = true
+		default:
+			return nil, apierrors.InvalidParameter("expand", "expand must be internal, token_transfers, logs, or contract_creation")
+		}
+	}
+
+	return expanded, nil
+}
+
+// newTransactionDetail - add the expanded related rows to a transaction
+func newTransactionDetail(transaction *models.TransactionAPIDetail, expand map[string]bool) (*TransactionDetail, error) {
+	detail := &TransactionDetail{
+		TransactionAPIDetail: transaction,
+	}
+
+	// Internal transactions
+	if expand[expandInternal] {
+		internalTransactions, err := crud.GetTransactionModel().SelectManyInternalAPIByHashes([]string{transaction.Hash})
+		if err != nil {
+			return nil, err
+		}
+
+		detail.InternalTransactions = internalTransactions
+	}
+
+	// Token transfers
+	if expand[expandTokenTransfers] {
+		tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByTransactionHashes([]string{transaction.Hash})
+		if err != nil {
+			return nil, err
+		}
+
+		detail.TokenTransfers = tokenTransfers
+	}
+
+	// Logs
+	if expand[expandLogs] {
+		logs := parseReceiptLogs(transaction.ReceiptLogs)
+		detail.Logs = &logs
+	}
+
+	// Contract creation
+	if expand[expandContractCreation] {
+		transactionCreateScore, err := crud.GetTransactionCreateScoreModel().SelectOneByTransactionHash(transaction.Hash)
+		if err == nil {
+			detail.ContractCreation = newContractCreation(transactionCreateScore)
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			if transaction.DataType == "deploy" {
+				// Deployment not reviewed yet
+				detail.ContractCreation = newContractCreation(&models.TransactionCreateScore{
+					CreationTransactionHash: transaction.Hash,
+				})
+			}
+		} else {
+			// Postgres error
+			return nil, err
+		}
+	}
+
+	return detail, nil
+}
+
+func newContractCreation(transactionCreateScore *models.TransactionCreateScore) *ContractCreation {
+	status := "pending"
+	if transactionCreateScore.AcceptTransactionHash != "" {
+		status = "accepted"
+	} else if transactionCreateScore.RejectTransactionHash != "" {
+		status = "rejected"
+	}
+
+	return &ContractCreation{
+		TransactionCreateScore: transactionCreateScore,
+		Status:                 status,
+	}
+}
+
+// parseReceiptLogs - parse the receipt_logs json string of a transaction
+// NOTE unparsable logs are returned empty
+func parseReceiptLogs(receiptLogs string) []TransactionLog {
+	logs := []TransactionLog{}
+
+	if receiptLogs == "" || receiptLogs == "None" {
+		// No logs
+		return logs
+	}
+
+	receiptLogsRaw := []struct {
+		ScoreAddress string        `json:"scoreAddress"`
+		Indexed      []string      `json:"indexed"`
+		Data         []interface{} `json:"data"`
+	}{}
+	err := json.Unmarshal([]byte(receiptLogs), &receiptLogsRaw)
+	if err != nil {
+		zap.S().Warn("Unable to parse receipt logs: ", err.Error())
+		return logs
+	}
+
+	for i, l := range receiptLogsRaw {
+		method := ""
+		if len(l.Indexed) > 0 {
+			method = strings.Split(l.Indexed[0], "(")[0]
+		}
+
+		logs = append(logs, TransactionLog{
+			LogIndex:     i,
+			ScoreAddress: l.ScoreAddress,
+			Method:       method,
+			Indexed:      l.Indexed,
+			Data:         l.Data,
+		})
+	}
+
+	return logs
+}
@@ -0,0 +1,50 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestParseExpand(t *testing.T) {
+	assert := assert.New(t)
+
+	expand, err := parseExpand("internal, logs")
+	assert.Equal(nil, err)
+	assert.Equal(map[string]bool{"internal": true, "logs": true}, expand)
+
+	expand, err = parseExpand("")
+	assert.Equal(nil, err)
+	assert.Equal(0, len(expand))
+
+	_, err = parseExpand("internal,blocks")
+	assert.NotEqual(nil, err)
+}
+
+func TestParseReceiptLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	logs := parseReceiptLogs(`[{"scoreAddress": "cx1", "indexed": ["Transfer(Address,Address,int,bytes)", "hx1", "hx2", "0x1"], "data": [null]}]`)
+	assert.Equal(1, len(logs))
+	assert.Equal(0, logs[0].LogIndex)
+	assert.Equal("cx1", logs[0].ScoreAddress)
+	assert.Equal("Transfer", logs[0].Method)
+	assert.Equal(4, len(logs[0].Indexed))
+	assert.Equal([]interface{}{nil}, logs[0].Data)
+
+	// No logs
+	assert.Equal(0, len(parseReceiptLogs("None")))
+	assert.Equal(0, len(parseReceiptLogs("not json")))
+}
+
+func TestNewContractCreation(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("pending", newContractCreation(&models.TransactionCreateScore{CreationTransactionHash: "0x1"}).Status)
+	assert.Equal("accepted", newContractCreation(&models.TransactionCreateScore{CreationTransactionHash: "0x1", AcceptTransactionHash: "0x2"}).Status)
+	assert.Equal("rejected", newContractCreation(&models.TransactionCreateScore{CreationTransactionHash: "0x1", RejectTransactionHash: "0x2"}).Status)
+}
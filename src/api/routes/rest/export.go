@@ -0,0 +1,239 @@
+package rest
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
+	"github.com/geometry-labs/icon-transactions/crud"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+
+	// Rows written between flushes to the client
+	exportFlushSize = 1000
+)
+
+// export - streams rows as csv or ndjson
+type export struct {
+	format       string
+	columns      []string
+	fieldIndexes []int
+	options      *crud.StreamOptions
+
+	w         *bufio.Writer
+	csvWriter *csv.Writer
+	rowCount  int
+}
+
+type exportStreamFunc func(write func(row interface{}) error) error
+
+// newExport - create an export if one is requested by the format param or Accept header
+// NOTE model is a pointer to the row type, columns are its json field names
+// Returns: export (nil if not requested), error (if params are invalid)
+func newExport(c *fiber.Ctx, params *TransactionsQuery, model interface{}) (*export, error) {
+
+	// Format
+	format := ""
+	accept := c.Get(fiber.HeaderAccept)
+	if params.Format == exportFormatCSV || (params.Format == "" && strings.Contains(accept, "text/csv")) {
+		format = exportFormatCSV
+	} else if params.Format == exportFormatNDJSON || (params.Format == "" && strings.Contains(accept, "application/x-ndjson")) {
+		format = exportFormatNDJSON
+	} else if params.Format != "" && params.Format != "json" {
+		return nil, apierrors.InvalidParameter("format", "format must be json, csv, or ndjson")
+	}
+	if format == "" {
+		return nil, nil
+	}
+
+	// Copy query strings
+	// NOTE the stream runs after the handler returns and fiber reuses the request memory
+	paramsValue := reflect.ValueOf(params).Elem()
+	for i := 0; i < paramsValue.NumField(); i++ {
+		field := paramsValue.Field(i)
+		if field.Kind() == reflect.String {
+			field.SetString(utils.CopyString(field.String()))
+		}
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Model fields
+	modelType := reflect.TypeOf(model).Elem()
+	fieldIndexesByColumn := map[string]int{}
+	allColumns := []string{}
+	for i := 0; i < modelType.NumField(); i++ {
+		jsonTag := modelType.Field(i).Tag.Get("json")
+		if jsonTag == "" || jsonTag == "-" {
+			continue
+		}
+
+		fieldIndexesByColumn[jsonTag] = i
+		allColumns = append(allColumns, jsonTag)
+	}
+
+	// Columns
+	columns := allColumns
+	if params.Columns != "" {
+		columns = strings.Split(params.Columns, ",")
+	}
+
+	fieldIndexes := make([]int, len(columns))
+	for i, column := range columns {
+		fieldIndex, ok := fieldIndexesByColumn[column]
+		if ok == false {
+			return nil, apierrors.InvalidParameter("columns", "invalid column: "+column)
+		}
+
+		fieldIndexes[i] = fieldIndex
+	}
+
+	return &export{
+		format:       format,
+		columns:      columns,
+		fieldIndexes: fieldIndexes,
+		options: &crud.StreamOptions{
+			Columns:          columns,
+			StartBlockNumber: params.StartBlockNumber,
+			EndBlockNumber:   params.EndBlockNumber,
+			StartTimestamp:   startTimestamp,
+			EndTimestamp:     endTimestamp,
+			Limit:            limits.MaxExportSize(c),
+		},
+	}, nil
+}
+
+// send - stream rows to the client
+// NOTE the body is written after the handler returns, errors can only be logged
+// NOTE path params used by stream must be copied with utils.CopyString
+func (e *export) send(c *fiber.Ctx, stream exportStreamFunc) error {
+
+	if e.format == exportFormatCSV {
+		c.Set(fiber.HeaderContentType, "text/csv")
+	} else {
+		c.Set(fiber.HeaderContentType, "application/x-ndjson")
+	}
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		e.w = w
+		if e.format == exportFormatCSV {
+			e.csvWriter = csv.NewWriter(w)
+		}
+
+		err := e.writeHeader()
+		if err == nil {
+			err = stream(e.writeRow)
+		}
+		if err == nil {
+			err = e.flush()
+		}
+		if err != nil {
+			zap.S().Warn("Export ERROR: ", err.Error())
+		}
+	})
+
+	return nil
+}
+
+func (e *export) writeHeader() error {
+	if e.format == exportFormatCSV {
+		return e.csvWriter.Write(e.columns)
+	}
+
+	return nil
+}
+
+func (e *export) writeRow(row interface{}) error {
+	rowValue := reflect.ValueOf(row).Elem()
+
+	if e.format == exportFormatCSV {
+		record := make([]string, len(e.fieldIndexes))
+		for i, fieldIndex := range e.fieldIndexes {
+			record[i] = exportValueString(rowValue.Field(fieldIndex))
+		}
+
+		err := e.csvWriter.Write(record)
+		if err != nil {
+			return err
+		}
+	} else {
+		// NOTE written field by field to keep the column order
+		line := []byte{'{'}
+		for i, fieldIndex := range e.fieldIndexes {
+			if i != 0 {
+				line = append(line, ',')
+			}
+
+			key, _ := json.Marshal(e.columns[i])
+			value, err := json.Marshal(rowValue.Field(fieldIndex).Interface())
+			if err != nil {
+				return err
+			}
+
+			line = append(line, key...)
+			line = append(line, ':')
+			line = append(line, value...)
+		}
+		line = append(line, '}', '\n')
+
+		_, err := e.w.Write(line)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Flush
+	e.rowCount++
+	if e.rowCount%exportFlushSize == 0 {
+		return e.flush()
+	}
+
+	return nil
+}
+
+func (e *export) flush() error {
+	if e.csvWriter != nil {
+		e.csvWriter.Flush()
+
+		err := e.csvWriter.Error()
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.w.Flush()
+}
+
+func exportValueString(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	}
+
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,80 @@
+//+build unit
+
+package rest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestExport(t *testing.T) {
+	assert := assert.New(t)
+
+	tokenHolders := &[]models.TokenHolder{
+		{TokenContractAddress: "cx1", HolderAddress: "hx1", Value: "0x2", ValueDecimal: 2},
+		{TokenContractAddress: "cx1", HolderAddress: "hx2", Value: "0x1", ValueDecimal: 1},
+	}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		params := new(TransactionsQuery)
+		if err := c.QueryParser(params); err != nil {
+			return err
+		}
+
+		return sendTokenHolders(
+			c,
+			params,
+			func(limit int, skip int) (*[]models.TokenHolder, error) {
+				return tokenHolders, nil
+			},
+			func() (int64, error) {
+				return int64(len(*tokenHolders)), nil
+			},
+		)
+	})
+
+	// CSV
+	resp, err := app.Test(httptest.NewRequest("GET", "/?format=csv&columns=holder_address,value_decimal", nil))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+	assert.Equal("text/csv", resp.Header.Get("Content-Type"))
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal("holder_address,value_decimal\nhx1,2\nhx2,1\n", string(bytes))
+
+	// NDJSON
+	req := httptest.NewRequest("GET", "/?columns=value,holder_address", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	resp, err = app.Test(req)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+	assert.Equal("application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+	assert.Equal("{\"value\":\"0x2\",\"holder_address\":\"hx1\"}\n{\"value\":\"0x1\",\"holder_address\":\"hx2\"}\n", string(bytes))
+
+	// Invalid column
+	resp, err = app.Test(httptest.NewRequest("GET", "/?format=csv&columns=hash", nil))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	envelope := &apierrors.Envelope{}
+	err = json.Unmarshal(bytes, envelope)
+	assert.Equal(nil, err)
+	assert.Equal(apierrors.CodeInvalidParameter, envelope.Error.Code)
+	assert.Equal("columns", envelope.Error.Field)
+}
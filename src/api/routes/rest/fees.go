@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// FeeStat - fee and step history of a stats bucket
+type FeeStat struct {
+	Period           string  `json:"period"`
+	BucketTimestamp  uint64  `json:"bucket_timestamp"`
+	TransactionCount uint64  `json:"transaction_count"`
+	FeeTotal         float64 `json:"fee_total"`
+	AverageFee       float64 `json:"average_fee"`
+	StepUsedTotal    uint64  `json:"step_used_total"`
+	AverageStepUsed  float64 `json:"average_step_used"`
+	AverageStepPrice float64 `json:"average_step_price"`
+}
+
+// newFeeStats - fee history from transaction stats buckets
+func newFeeStats(transactionStats *[]models.TransactionStat) []FeeStat {
+
+	feeStats := make([]FeeStat, len(*transactionStats))
+	for i, s := range *transactionStats {
+		feeStats[i] = FeeStat{
+			Period:           s.Period,
+			BucketTimestamp:  s.BucketTimestamp,
+			TransactionCount: s.TransactionCount,
+			FeeTotal:         s.FeeTotal,
+			StepUsedTotal:    s.StepUsedTotal,
+			AverageStepPrice: s.AverageStepPrice,
+		}
+
+		if s.TransactionCount != 0 {
+			feeStats[i].AverageFee = s.FeeTotal / float64(s.TransactionCount)
+			feeStats[i].AverageStepUsed = float64(s.StepUsedTotal) / float64(s.TransactionCount)
+		}
+	}
+
+	return feeStats
+}
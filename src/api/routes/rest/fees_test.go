@@ -0,0 +1,41 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestNewFeeStats(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionStats := &[]models.TransactionStat{
+		{
+			Period:           "daily",
+			BucketTimestamp:  86400000000,
+			TransactionCount: 4,
+			FeeTotal:         2,
+			StepUsedTotal:    400000,
+			AverageStepPrice: 12500000000,
+		},
+		{
+			Period:          "daily",
+			BucketTimestamp: 0,
+		},
+	}
+
+	feeStats := newFeeStats(transactionStats)
+	assert.Equal(2, len(feeStats))
+	assert.Equal(uint64(86400000000), feeStats[0].BucketTimestamp)
+	assert.Equal(0.5, feeStats[0].AverageFee)
+	assert.Equal(100000.0, feeStats[0].AverageStepUsed)
+	assert.Equal(12500000000.0, feeStats[0].AverageStepPrice)
+
+	// Empty bucket
+	assert.Equal(0.0, feeStats[1].AverageFee)
+	assert.Equal(0.0, feeStats[1].AverageStepUsed)
+}
@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/crud"
+)
+
+// newTransactionFilters - parse and check the transaction list filters
+func newTransactionFilters(params *TransactionsQuery) (*crud.TransactionFilters, error) {
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Values
+	minValue, err := parseValue(params.MinValue)
+	if err != nil {
+		return nil, apierrors.InvalidParameter("min_value", "min_value must be ICX or 0x prefixed hex loop")
+	}
+	maxValue, err := parseValue(params.MaxValue)
+	if err != nil {
+		return nil, apierrors.InvalidParameter("max_value", "max_value must be ICX or 0x prefixed hex loop")
+	}
+
+	// Status
+	if params.Status != "" && params.Status != "success" && params.Status != "failed" {
+		return nil, apierrors.InvalidParameter("status", "status must be success or failed")
+	}
+
+	// Sort
+	if params.Sort != "desc" && params.Sort != "asc" {
+		params.Sort = "desc"
+	}
+	if params.SortBy != "" && params.SortBy != "value" && params.SortBy != "fee" && params.SortBy != "block_number" {
+		return nil, apierrors.InvalidParameter("sort_by", "sort_by must be value, fee, or block_number")
+	}
+
+	// NOTE: casting string types for type field
+	if params.Type == "regular" {
+		params.Type = "transaction"
+	} else if params.Type == "internal" {
+		params.Type = "log"
+	}
+
+	return &crud.TransactionFilters{
+		From:             splitList(params.From),
+		To:               splitList(params.To),
+		Address:          params.Address,
+		Type:             params.Type,
+		BlockNumber:      params.BlockNumber,
+		StartBlockNumber: params.StartBlockNumber,
+		EndBlockNumber:   params.EndBlockNumber,
+		StartTimestamp:   startTimestamp,
+		EndTimestamp:     endTimestamp,
+		Method:           splitList(params.Method),
+		MinValue:         minValue,
+		MaxValue:         maxValue,
+		Status:           params.Status,
+		Sort:             params.Sort,
+		SortBy:           params.SortBy,
+	}, nil
+}
+
+// parseValue - parse an ICX amount ("10000", "0.5") or a 0x prefixed hex loop amount
+// Returns: 0x prefixed hex loop without leading zeros ("" if empty), error (if present)
+func parseValue(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	loop := new(big.Int)
+	if strings.HasPrefix(value, "0x") {
+		// Loop
+		_, ok := loop.SetString(strings.TrimPrefix(value, "0x"), 16)
+		if ok == false {
+			return "", errors.New("invalid hex value")
+		}
+	} else {
+		// ICX
+		icx, ok := new(big.Rat).SetString(value)
+		if ok == false {
+			return "", errors.New("invalid value")
+		}
+
+		icx.Mul(icx, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+		if icx.IsInt() == false {
+			return "", errors.New("value has more than 18 decimals")
+		}
+
+		loop = icx.Num()
+	}
+
+	if loop.Sign() < 0 {
+		return "", errors.New("negative value")
+	}
+
+	return "0x" + loop.Text(16), nil
+}
+
+// splitList - split a comma separated param
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
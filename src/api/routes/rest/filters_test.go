@@ -0,0 +1,50 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseValue(t *testing.T) {
+	assert := assert.New(t)
+
+	// Empty
+	value, err := parseValue("")
+	assert.Equal(nil, err)
+	assert.Equal("", value)
+
+	// ICX
+	value, err = parseValue("10000")
+	assert.Equal(nil, err)
+	assert.Equal("0x21e19e0c9bab2400000", value)
+
+	value, err = parseValue("0.5")
+	assert.Equal(nil, err)
+	assert.Equal("0x6f05b59d3b20000", value)
+
+	// Loop
+	value, err = parseValue("0x00ff")
+	assert.Equal(nil, err)
+	assert.Equal("0xff", value)
+
+	// Invalid
+	_, err = parseValue("0.0000000000000000001")
+	assert.NotEqual(nil, err)
+
+	_, err = parseValue("-1")
+	assert.NotEqual(nil, err)
+
+	_, err = parseValue("0xzz")
+	assert.NotEqual(nil, err)
+}
+
+func TestSplitList(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string(nil), splitList(""))
+	assert.Equal([]string{"hx1"}, splitList("hx1"))
+	assert.Equal([]string{"hx1", "hx2"}, splitList("hx1, hx2,"))
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// RankedTokenHolder - token holder with rank and share of total supply
+type RankedTokenHolder struct {
+	*models.TokenHolder
+
+	Rank int `json:"rank"`
+
+	// Percentage of total supply, null until the holder distribution is computed
+	Percentage *float64 `json:"percentage"`
+}
+
+// RankedIcxHolder - ICX rich list entry with rank and share of total supply
+type RankedIcxHolder struct {
+	*models.IcxBalance
+
+	Rank int `json:"rank"`
+
+	// Percentage of total supply, null until the holder distribution is computed
+	Percentage *float64 `json:"percentage"`
+}
+
+// holderPercentage - share of total supply of a balance
+// NOTE nil if the distribution or its total supply is missing
+func holderPercentage(valueDecimal float64, holderDistribution *models.HolderDistribution) *float64 {
+	if holderDistribution == nil || holderDistribution.TotalSupplyDecimal <= 0 {
+		return nil
+	}
+
+	percentage := valueDecimal / holderDistribution.TotalSupplyDecimal * 100
+	return &percentage
+}
+
+// newRankedTokenHolders - rank holders sorted by balance, starting after skip
+func newRankedTokenHolders(
+	tokenHolders *[]models.TokenHolder,
+	skip int,
+	holderDistribution *models.HolderDistribution,
+) []RankedTokenHolder {
+
+	rankedTokenHolders := make([]RankedTokenHolder, len(*tokenHolders))
+	for i := range *tokenHolders {
+		t := &(*tokenHolders)[i]
+
+		rankedTokenHolders[i] = RankedTokenHolder{
+			TokenHolder: t,
+			Rank:        skip + i + 1,
+			Percentage:  holderPercentage(t.ValueDecimal, holderDistribution),
+		}
+	}
+
+	return rankedTokenHolders
+}
+
+// newRankedIcxHolders - rank ICX balances sorted by balance, starting after skip
+func newRankedIcxHolders(
+	icxBalances *[]models.IcxBalance,
+	skip int,
+	holderDistribution *models.HolderDistribution,
+) []RankedIcxHolder {
+
+	rankedIcxHolders := make([]RankedIcxHolder, len(*icxBalances))
+	for i := range *icxBalances {
+		b := &(*icxBalances)[i]
+
+		rankedIcxHolders[i] = RankedIcxHolder{
+			IcxBalance: b,
+			Rank:       skip + i + 1,
+			Percentage: holderPercentage(b.ValueDecimal, holderDistribution),
+		}
+	}
+
+	return rankedIcxHolders
+}
+
+// selectHolderDistribution - holder distribution or nil if not computed yet
+func selectHolderDistribution(tokenContractAddress string) (*models.HolderDistribution, error) {
+	holderDistribution, err := crud.GetHolderDistributionModel().SelectOne(tokenContractAddress)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return holderDistribution, nil
+}
@@ -0,0 +1,50 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestNewRankedTokenHolders(t *testing.T) {
+	assert := assert.New(t)
+
+	tokenHolders := &[]models.TokenHolder{
+		{HolderAddress: "hx1", ValueDecimal: 50},
+		{HolderAddress: "hx2", ValueDecimal: 25},
+	}
+
+	// Distribution computed
+	rankedTokenHolders := newRankedTokenHolders(tokenHolders, 10, &models.HolderDistribution{TotalSupplyDecimal: 200})
+	assert.Equal(2, len(rankedTokenHolders))
+	assert.Equal(11, rankedTokenHolders[0].Rank)
+	assert.Equal(12, rankedTokenHolders[1].Rank)
+	assert.Equal(25.0, *rankedTokenHolders[0].Percentage)
+	assert.Equal(12.5, *rankedTokenHolders[1].Percentage)
+	assert.Equal("hx1", rankedTokenHolders[0].HolderAddress)
+
+	// No distribution
+	rankedTokenHolders = newRankedTokenHolders(tokenHolders, 0, nil)
+	assert.Equal(1, rankedTokenHolders[0].Rank)
+	assert.Nil(rankedTokenHolders[0].Percentage)
+
+	// Unknown total supply
+	rankedTokenHolders = newRankedTokenHolders(tokenHolders, 0, &models.HolderDistribution{})
+	assert.Nil(rankedTokenHolders[0].Percentage)
+}
+
+func TestNewRankedIcxHolders(t *testing.T) {
+	assert := assert.New(t)
+
+	icxBalances := &[]models.IcxBalance{
+		{Address: "hx1", ValueDecimal: 1000},
+	}
+
+	rankedIcxHolders := newRankedIcxHolders(icxBalances, 0, &models.HolderDistribution{TotalSupplyDecimal: 4000})
+	assert.Equal(1, rankedIcxHolders[0].Rank)
+	assert.Equal(25.0, *rankedIcxHolders[0].Percentage)
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/crud"
+)
+
+// parseTimestamp - parse unix micro seconds or RFC3339
+// Returns: unix micro seconds (0 if empty), error (if present)
+func parseTimestamp(timestamp string) (int64, error) {
+	if timestamp == "" {
+		return 0, nil
+	}
+
+	// Unix micro seconds
+	micros, err := strconv.ParseInt(timestamp, 10, 64)
+	if err == nil {
+		return micros, nil
+	}
+
+	// RFC3339
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.UnixNano() / 1000, nil
+}
+
+// parseTimestampRange - parse start_timestamp and end_timestamp
+// Returns: start and end in unix micro seconds, error (if present)
+func parseTimestampRange(params *TransactionsQuery) (int64, int64, error) {
+	startTimestamp, err := parseTimestamp(params.StartTimestamp)
+	if err != nil {
+		return 0, 0, apierrors.InvalidParameter("start_timestamp", "start_timestamp must be unix micro seconds or RFC3339")
+	}
+
+	endTimestamp, err := parseTimestamp(params.EndTimestamp)
+	if err != nil {
+		return 0, 0, apierrors.InvalidParameter("end_timestamp", "end_timestamp must be unix micro seconds or RFC3339")
+	}
+
+	return startTimestamp, endTimestamp, nil
+}
+
+// timestampRangeToBlockRange - block range covering a timestamp range
+// NOTE used by address queries, the address indices only store block numbers
+// Returns: start and end block number (0 if open), error (if present)
+func timestampRangeToBlockRange(startTimestamp int64, endTimestamp int64) (int, int, error) {
+	startBlockNumber := 0
+	endBlockNumber := 0
+
+	// First block at or after start
+	if startTimestamp != 0 {
+		transaction, err := crud.GetTransactionModel().SelectOneByTimestamp(startTimestamp, "after")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Range starts after the latest block
+			startBlockNumber = math.MaxInt32
+		} else if err != nil {
+			return 0, 0, err
+		} else {
+			startBlockNumber = int(transaction.BlockNumber)
+		}
+	}
+
+	// Last block at or before end
+	if endTimestamp != 0 {
+		transaction, err := crud.GetTransactionModel().SelectOneByTimestamp(endTimestamp, "before")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Range ends before the first block
+			startBlockNumber = math.MaxInt32
+		} else if err != nil {
+			return 0, 0, err
+		} else {
+			endBlockNumber = int(transaction.BlockNumber)
+		}
+	}
+
+	return startBlockNumber, endBlockNumber, nil
+}
@@ -0,0 +1,36 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	assert := assert.New(t)
+
+	// Empty
+	timestamp, err := parseTimestamp("")
+	assert.Equal(nil, err)
+	assert.Equal(int64(0), timestamp)
+
+	// Unix micro seconds
+	timestamp, err = parseTimestamp("1614556800000000")
+	assert.Equal(nil, err)
+	assert.Equal(int64(1614556800000000), timestamp)
+
+	// RFC3339
+	timestamp, err = parseTimestamp("2021-03-01T00:00:00Z")
+	assert.Equal(nil, err)
+	assert.Equal(int64(1614556800000000), timestamp)
+
+	timestamp, err = parseTimestamp("2021-03-01T09:00:00+09:00")
+	assert.Equal(nil, err)
+	assert.Equal(int64(1614556800000000), timestamp)
+
+	// Invalid
+	_, err = parseTimestamp("2021-03-01")
+	assert.NotEqual(nil, err)
+}
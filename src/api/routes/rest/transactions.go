@@ -2,13 +2,20 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"strconv"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/api/routes/apierrors"
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
 	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
 )
 
 type TransactionsQuery struct {
@@ -22,8 +29,22 @@ type TransactionsQuery struct {
 	EndBlockNumber       int    `query:"end_block_number"`
 	Method               string `query:"method"`
 	TransactionHash      string `query:"transaction_hash"`
-	Sort                 string `query:sort`
-	TokenContractAddress string `query:token_contract_address`
+	Sort                 string `query:"sort"`
+	TokenContractAddress string `query:"token_contract_address"`
+	TokenID              string `query:"token_id"`
+	Format               string `query:"format"`
+	Columns              string `query:"columns"`
+	StartTimestamp       string `query:"start_timestamp"`
+	EndTimestamp         string `query:"end_timestamp"`
+	Closest              string `query:"closest"`
+	Address              string `query:"address"`
+	MinValue             string `query:"min_value"`
+	MaxValue             string `query:"max_value"`
+	Status               string `query:"status"`
+	SortBy               string `query:"sort_by"`
+	Expand               string `query:"expand"`
+	Deployer             string `query:"deployer"`
+	Period               string `query:"period"`
 }
 
 func TransactionsAddHandlers(app *fiber.App) {
@@ -32,7 +53,9 @@ func TransactionsAddHandlers(app *fiber.App) {
 
 	app.Get(prefix+"/", handlerGetTransactions)
 	app.Get(prefix+"/details/:hash", handlerGetTransactionDetails)
+	app.Post(prefix+"/details", handlerPostTransactionDetails)
 	app.Get(prefix+"/block-number/:block_number", handlerGetTransactionBlockNumber)
+	app.Get(prefix+"/timestamp/:timestamp", handlerGetBlockNumberByTimestamp)
 	app.Get(prefix+"/address/:address", handlerGetTransactionAddress)
 	app.Get(prefix+"/internal/:hash", handlerGetInternalTransactionsByHash)
 	app.Get(prefix+"/internal/address/:address", handlerGetInternalTransactionsAddress)
@@ -40,6 +63,24 @@ func TransactionsAddHandlers(app *fiber.App) {
 	app.Get(prefix+"/token-transfers/address/:address", handlerGetTokenTransfersAddress)
 	app.Get(prefix+"/token-transfers/token-contract/:token_contract_address", handlerGetTokenTransfersTokenContract)
 	app.Get(prefix+"/token-holders/token-contract/:token_contract_address", handlerGetTokenHoldersTokenContract)
+	app.Get(prefix+"/token-holders/token-contract/:token_contract_address/distribution", handlerGetTokenHoldersTokenContractDistribution)
+	app.Get(prefix+"/token-holders/token-contract/:token_contract_address/at/:block_number", handlerGetTokenHoldersTokenContractAtBlock)
+	app.Get(prefix+"/token-holders/address/:address/at/:block_number", handlerGetTokenHoldersAddressAtBlock)
+	app.Get(prefix+"/icx-holders", handlerGetIcxHolders)
+	app.Get(prefix+"/icx-holders/distribution", handlerGetIcxHoldersDistribution)
+	app.Get(prefix+"/token-transfers/irc31", handlerGetMultiTokenTransfers)
+	app.Get(prefix+"/token-transfers/irc31/address/:address", handlerGetMultiTokenTransfersAddress)
+	app.Get(prefix+"/token-transfers/irc31/token-contract/:token_contract_address", handlerGetMultiTokenTransfersTokenContract)
+	app.Get(prefix+"/token-transfers/irc31/token-contract/:token_contract_address/holders", handlerGetMultiTokenHoldersTokenContract)
+	app.Get(prefix+"/contracts", handlerGetContracts)
+	app.Get(prefix+"/contracts/:address", handlerGetContract)
+	app.Get(prefix+"/stats/daily", handlerGetTransactionStatsDaily)
+	app.Get(prefix+"/stats/hourly", handlerGetTransactionStatsHourly)
+	app.Get(prefix+"/stats/fees", handlerGetFeeStats)
+	app.Get(prefix+"/address/:address/fees", handlerGetAddressFeeStats)
+	app.Get(prefix+"/contracts/:address/fees", handlerGetContractFeeStats)
+	app.Get(prefix+"/token-contracts/:token_contract_address/stats", handlerGetTokenContractStats)
+	app.Get(prefix+"/token-contracts/:token_contract_address/stats/top-movers", handlerGetTokenContractTopMovers)
 }
 
 // Transactions
@@ -48,89 +89,119 @@ func TransactionsAddHandlers(app *fiber.App) {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
-// @Param from query string false "find by from address"
-// @Param to query string false "find by to address"
+// @Param from query string false "find by from addresses, comma separated"
+// @Param to query string false "find by to addresses, comma separated"
+// @Param address query string false "find by from or to address"
 // @Param type query string false "find by type"
 // @Param block_number query int false "find by block number"
 // @Param start_block_number query int false "find by block number range"
 // @Param end_block_number query int false "find by block number range"
-// @Param method query string false "find by method"
+// @Param method query string false "find by methods, comma separated"
+// @Param min_value query string false "find by value range, ICX or 0x prefixed hex loop"
+// @Param max_value query string false "find by value range, ICX or 0x prefixed hex loop"
+// @Param status query string false "success or failed"
 // @Param sort query string false "desc or asc"
+// @Param sort_by query string false "value, fee, or block_number (default)"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions [get]
 // @Success 200 {object} []models.TransactionAPIList
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTransactions(c *fiber.Ctx) error {
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	// NOTE created before the filters so they use the copied params
+	export, err := newExport(c, params, &models.TransactionAPIList{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Filters
+	filters, err := newTransactionFilters(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	if export != nil {
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTransactionModel().StreamManyAPI(filters, export.options, func(t *models.TransactionAPIList) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
 	if params.Limit <= 0 {
 		params.Limit = 25
 	}
-	if params.Sort == "" {
-		params.Sort = "desc"
-	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
-	}
-	if params.Sort != "desc" && params.Sort != "asc" {
-		params.Sort = "desc"
-	}
-
-	// NOTE: casting string types for type field
-	if params.Type == "regular" {
-		params.Type = "transaction"
-	} else if params.Type == "internal" {
-		params.Type = "log"
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
 	}
 
 	// Get Transactions
 	transactions, err := crud.GetTransactionModel().SelectManyAPI(
 		params.Limit,
 		params.Skip,
-		params.From,
-		params.To,
-		params.Type,
-		params.BlockNumber,
-		params.StartBlockNumber,
-		params.EndBlockNumber,
-		params.Method,
-		params.Sort,
+		filters,
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
+	// Set X-TOTAL-COUNT
+	// NOTE maintained counters only exist for unfiltered lists
+	count, isEstimated := int64(0), false
+	if filters.IsEmpty() {
+		counterTypes := []string{"regular", "internal"}
+		if filters.Type == "transaction" {
+			counterTypes = []string{"regular"}
+		} else if filters.Type == "log" {
+			counterTypes = []string{"internal"}
+		}
+
+		for _, counterType := range counterTypes {
+			counter, err := crud.GetTransactionCountModel().SelectCount(counterType)
+			if err != nil {
+				counter = 0
+				zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+			}
+			count += int64(counter)
+		}
+	} else {
+		count, isEstimated, err = crud.GetTransactionModel().CountAPI(filters, config.Config.TotalCountCap)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
+
 	if len(*transactions) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
-	// Set X-TOTAL-COUNT
-	counter, err := crud.GetTransactionCountModel().SelectCount("regular")
-	if err != nil {
-		counter = 0
-		zap.S().Warn("Could not retrieve transaction count: ", err.Error())
-	}
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(counter, 10))
-
 	body, _ := json.Marshal(&transactions)
 	return c.SendString(string(body))
 }
@@ -143,61 +214,244 @@ func handlerGetTransactions(c *fiber.Ctx) error {
 // @Accept */*
 // @Produce json
 // @Param hash path string true "transaction hash"
+// @Param expand query string false "comma separated related rows: internal, token_transfers, logs, contract_creation"
 // @Router /api/v1/transactions/details/{hash} [get]
-// @Success 200 {object} models.TransactionAPIDetail
-// @Failure 422 {object} map[string]interface{}
+// @Success 200 {object} TransactionDetail
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 404 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTransactionDetails(c *fiber.Ctx) error {
 	hash := c.Params("hash")
 
 	if hash == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "hash required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("hash", "hash required"))
 	}
 
-	transaction, err := crud.GetTransactionModel().SelectOneAPI(hash, -1)
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Expand
+	expand, err := parseExpand(params.Expand)
 	if err != nil {
-		c.Status(404)
+		return apierrors.Send(c, err)
+	}
+
+	transaction, err := crud.GetTransactionModel().SelectOneAPI(hash, -1)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return apierrors.Send(c, apierrors.NotFound("no transaction found"))
+	} else if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transaction"))
+	}
 
-		zap.S().Warn(err.Error())
-		return c.SendString(`{"error": "no transaction found"}`)
+	detail, err := newTransactionDetail(transaction, expand)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transaction details"))
 	}
 
-	body, _ := json.Marshal(&transaction)
+	body, _ := json.Marshal(&detail)
 	return c.SendString(string(body))
 }
 
+// TransactionDetail - transaction detail with related rows
+type TransactionDetail struct {
+	*models.TransactionAPIDetail
+
+	InternalTransactions *[]models.TransactionInternalAPIList `json:"internal_transactions,omitempty"`
+	TokenTransfers       *[]models.TokenTransfer              `json:"token_transfers,omitempty"`
+	Logs                 *[]TransactionLog                    `json:"logs,omitempty"`
+	ContractCreation     *ContractCreation                    `json:"contract_creation,omitempty"`
+}
+
+// TransactionDetailsBatchBody - body of a batch transaction details request
+type TransactionDetailsBatchBody struct {
+	Hashes []string `json:"hashes"`
+}
+
+// TransactionDetailsBatch - response of a batch transaction details request
+type TransactionDetailsBatch struct {
+	Transactions []TransactionDetail `json:"transactions"`
+	NotFound     []string            `json:"not_found"`
+}
+
+// Batch Transaction Details
+// @Summary Get Many Transaction Details
+// @Description get details of many transactions with their internal transactions and token transfers
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept json
+// @Produce json
+// @Param body body TransactionDetailsBatchBody true "transaction hashes"
+// @Router /api/v1/transactions/details [post]
+// @Success 200 {object} TransactionDetailsBatch
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerPostTransactionDetails(c *fiber.Ctx) error {
+	body := new(TransactionDetailsBatchBody)
+	if err := c.BodyParser(body); err != nil {
+		zap.S().Warnf("Transactions Post Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse body"))
+	}
+
+	// Unique hashes in request order
+	hashes := []string{}
+	isDuplicate := map[string]bool{}
+	for _, hash := range body.Hashes {
+		if hash == "" || isDuplicate[hash] {
+			continue
+		}
+
+		isDuplicate[hash] = true
+		hashes = append(hashes, hash)
+	}
+
+	// Check Params
+	if len(hashes) < 1 || len(hashes) > config.Config.MaxBatchSize {
+		return apierrors.Send(c, apierrors.InvalidParameter("hashes", "hashes must have at least 1 and at most "+strconv.Itoa(config.Config.MaxBatchSize)+" hashes"))
+	}
+
+	// Get Transactions
+	transactions, err := crud.GetTransactionModel().SelectManyAPIByHashes(hashes)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
+	}
+
+	// Get Internal Transactions
+	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalAPIByHashes(hashes)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve internal transactions"))
+	}
+
+	// Get Token Transfers
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByTransactionHashes(hashes)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve token transfers"))
+	}
+
+	batch := newTransactionDetailsBatch(hashes, transactions, internalTransactions, tokenTransfers)
+
+	response, _ := json.Marshal(&batch)
+	return c.SendString(string(response))
+}
+
+// newTransactionDetailsBatch - group related rows by transaction in request order
+func newTransactionDetailsBatch(
+	hashes []string,
+	transactions *[]models.TransactionAPIDetail,
+	internalTransactions *[]models.TransactionInternalAPIList,
+	tokenTransfers *[]models.TokenTransfer,
+) *TransactionDetailsBatch {
+
+	details := map[string]*TransactionDetail{}
+	for i := range *transactions {
+		t := &(*transactions)[i]
+
+		details[t.Hash] = &TransactionDetail{
+			TransactionAPIDetail: t,
+			InternalTransactions: &[]models.TransactionInternalAPIList{},
+			TokenTransfers:       &[]models.TokenTransfer{},
+		}
+	}
+
+	for i := range *internalTransactions {
+		t := &(*internalTransactions)[i]
+
+		detail, ok := details[t.Hash]
+		if ok == false {
+			continue
+		}
+
+		*detail.InternalTransactions = append(*detail.InternalTransactions, *t)
+	}
+
+	for i := range *tokenTransfers {
+		t := &(*tokenTransfers)[i]
+
+		detail, ok := details[t.TransactionHash]
+		if ok == false {
+			continue
+		}
+
+		*detail.TokenTransfers = append(*detail.TokenTransfers, *t)
+	}
+
+	batch := &TransactionDetailsBatch{
+		Transactions: []TransactionDetail{},
+		NotFound:     []string{},
+	}
+	for _, hash := range hashes {
+		detail, ok := details[hash]
+		if ok == false {
+			batch.NotFound = append(batch.NotFound, hash)
+			continue
+		}
+
+		batch.Transactions = append(batch.Transactions, *detail)
+	}
+
+	return batch
+}
+
 // Transactions by Block Number
 // @Summary Get Transactions by block_number
 // @Description get transactions by block_number
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param block_number path string true "block_number"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
 // @Router /api/v1/transactions/block-number/{block_number} [get]
 // @Success 200 {object} models.TransactionAPIList
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTransactionBlockNumber(c *fiber.Ctx) error {
 	blockNumberRaw := c.Params("block_number")
 	if blockNumberRaw == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "block_number required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("block_number", "block_number required"))
 	}
 
 	blockNumber, err := strconv.Atoi(blockNumberRaw)
 	if err != nil {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid block_number"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("block_number", "block_number must be an integer"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TransactionAPIList{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTransactionModel().StreamManyAPI(&crud.TransactionFilters{BlockNumber: blockNumber, Sort: "desc"}, export.options, func(t *models.TransactionAPIList) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -206,32 +460,25 @@ func handlerGetTransactionBlockNumber(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
 	}
 
 	// Get Transactions
 	transactions, err := crud.GetTransactionModel().SelectManyAPI(
 		params.Limit,
 		params.Skip,
-		"",
-		"",
-		"",
-		blockNumber,
-		0,
-		0,
-		"",
-		"desc",
+		&crud.TransactionFilters{
+			BlockNumber: blockNumber,
+			Sort:        "desc",
+		},
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	// X-TOTAL-COUNT
@@ -247,32 +494,111 @@ func handlerGetTransactionBlockNumber(c *fiber.Ctx) error {
 	return c.SendString(string(body))
 }
 
+// BlockNumberByTimestamp - block closest to a timestamp
+type BlockNumberByTimestamp struct {
+	BlockNumber    uint64 `json:"block_number"`
+	BlockTimestamp uint64 `json:"block_timestamp"`
+}
+
+// Block Number by Timestamp
+// @Summary Get block number by timestamp
+// @Description get the block closest to a timestamp, used to turn dates into block ranges
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param timestamp path string true "unix micro seconds or RFC3339"
+// @Param closest query string false "before, after, or nearest (default)"
+// @Router /api/v1/transactions/timestamp/{timestamp} [get]
+// @Success 200 {object} BlockNumberByTimestamp
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 404 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetBlockNumberByTimestamp(c *fiber.Ctx) error {
+	timestamp, err := parseTimestamp(c.Params("timestamp"))
+	if err != nil || timestamp <= 0 {
+		return apierrors.Send(c, apierrors.InvalidParameter("timestamp", "timestamp must be unix micro seconds or RFC3339"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Check Params
+	if params.Closest == "nearest" {
+		params.Closest = ""
+	}
+	if params.Closest != "" && params.Closest != "before" && params.Closest != "after" {
+		return apierrors.Send(c, apierrors.InvalidParameter("closest", "closest must be before, after, or nearest"))
+	}
+
+	transaction, err := crud.GetTransactionModel().SelectOneByTimestamp(timestamp, params.Closest)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return apierrors.Send(c, apierrors.NotFound("no block found"))
+	} else if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve block"))
+	}
+
+	body, _ := json.Marshal(&BlockNumberByTimestamp{
+		BlockNumber:    transaction.BlockNumber,
+		BlockTimestamp: transaction.BlockTimestamp,
+	})
+	return c.SendString(string(body))
+}
+
 // Transactions by Address
 // @Summary Get Transactions by address
 // @Description get transactions by address
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param address path string true "address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions/address/{address} [get]
 // @Success 200 {object} models.TransactionAPIList
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTransactionAddress(c *fiber.Ctx) error {
 	address := c.Params("address")
 	if address == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "address required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TransactionAPIList{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		address := utils.CopyString(address)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTransactionModel().StreamManyByAddressAPI(address, export.options, func(t *models.TransactionAPIList) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -281,33 +607,61 @@ func handlerGetTransactionAddress(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Timestamps to block numbers
+	startBlockNumber, endBlockNumber, err := timestampRangeToBlockRange(startTimestamp, endTimestamp)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	transactions, err := crud.GetTransactionModel().SelectManyByAddressAPI(
 		params.Limit,
 		params.Skip,
 		address,
+		startBlockNumber,
+		endBlockNumber,
 	)
 	if err != nil {
-		c.Status(500)
-		return c.SendString(`{"error": "no transactions found"}`)
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	// X-TOTAL-COUNT
-	count, err := crud.GetTransactionCountByAddressModel().SelectCount(address)
-	if err != nil {
-		count = 0
-		zap.S().Warn("Could not retrieve transaction count: ", err.Error())
-	}
-
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
+	// NOTE the maintained counter only exists for the unfiltered list
+	count, isEstimated := int64(0), false
+	if startBlockNumber == 0 && endBlockNumber == 0 {
+		counter, err := crud.GetTransactionCountByAddressModel().SelectCount(address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+		count = int64(counter)
+	} else {
+		count, isEstimated, err = crud.GetTransactionCountByAddressIndexModel().CountByAddressBlockRange(
+			address,
+			startBlockNumber,
+			endBlockNumber,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
 
 	body, _ := json.Marshal(&transactions)
 	return c.SendString(string(body))
@@ -319,26 +673,47 @@ func handlerGetTransactionAddress(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param hash path string true "find by hash"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339, csv and ndjson only"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339, csv and ndjson only"
 // @Router /api/v1/transactions/internal/{hash} [get]
 // @Success 200 {object} []models.TransactionInternalAPIList
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetInternalTransactionsByHash(c *fiber.Ctx) error {
 	hash := c.Params("hash")
 	if hash == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "hash required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("hash", "hash required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TransactionInternalAPIList{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		hash := utils.CopyString(hash)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTransactionModel().StreamManyInternalAPI(hash, export.options, func(t *models.TransactionInternalAPIList) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -347,18 +722,15 @@ func handlerGetInternalTransactionsByHash(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
 	}
 
 	if hash == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "hash required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("hash", "hash required"))
 	}
 
 	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalAPI(
@@ -367,13 +739,14 @@ func handlerGetInternalTransactionsByHash(c *fiber.Ctx) error {
 		hash,
 	)
 	if err != nil {
-		c.Status(500)
-		return c.SendString(`{"error": "no internal transaction found"}`)
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve internal transactions"))
 	}
 
 	if len(*internalTransactions) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
 	body, _ := json.Marshal(&internalTransactions)
@@ -386,26 +759,47 @@ func handlerGetInternalTransactionsByHash(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param address path string true "find by address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions/internal/address/{address} [get]
 // @Success 200 {object} []models.TransactionInternalAPIList
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetInternalTransactionsAddress(c *fiber.Ctx) error {
 	address := c.Params("address")
 	if address == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "address required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TransactionInternalAPIList{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		address := utils.CopyString(address)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTransactionModel().StreamManyInternalByAddressAPI(address, export.options, func(t *models.TransactionInternalAPIList) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -414,39 +808,68 @@ func handlerGetInternalTransactionsAddress(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Timestamps to block numbers
+	startBlockNumber, endBlockNumber, err := timestampRangeToBlockRange(startTimestamp, endTimestamp)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalByAddressAPI(
 		params.Limit,
 		params.Skip,
 		address,
+		startBlockNumber,
+		endBlockNumber,
 	)
 	if err != nil {
-		c.Status(500)
-		return c.SendString(`{"error": "no internal transaction found"}`)
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve internal transactions"))
 	}
 
+	// X-TOTAL-COUNT
+	// NOTE the maintained counter only exists for the unfiltered list
+	count, isEstimated := int64(0), false
+	if startBlockNumber == 0 && endBlockNumber == 0 {
+		counter, err := crud.GetTransactionInternalCountByAddressModel().SelectCount(address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+		count = int64(counter)
+	} else {
+		count, isEstimated, err = crud.GetTransactionInternalCountByAddressIndexModel().CountByAddressBlockRange(
+			address,
+			startBlockNumber,
+			endBlockNumber,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
+
 	if len(*internalTransactions) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
-	// X-TOTAL-COUNT
-	count, err := crud.GetTransactionInternalCountByAddressModel().SelectCount(address)
-	if err != nil {
-		count = 0
-		zap.S().Warn("Could not retrieve transaction count: ", err.Error())
-	}
-
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
-
 	body, _ := json.Marshal(&internalTransactions)
 	return c.SendString(string(body))
 }
@@ -457,7 +880,7 @@ func handlerGetInternalTransactionsAddress(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param from query string false "find by from address"
@@ -467,16 +890,35 @@ func handlerGetInternalTransactionsAddress(c *fiber.Ctx) error {
 // @Param end_block_number query int false "find by block number range"
 // @Param token_contract_address query string false "find by token contract"
 // @Param transaction_hash query string false "find by transaction hash"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions/token-transfers [get]
 // @Success 200 {object} []models.TokenTransfer
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTokenTransfers(c *fiber.Ctx) error {
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTokenTransferModel().StreamMany(params.From, params.To, params.BlockNumber, params.TransactionHash, params.TokenContractAddress, export.options, func(t *models.TokenTransfer) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -485,13 +927,17 @@ func handlerGetTokenTransfers(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
 	}
 
 	// Get Transactions
@@ -503,28 +949,60 @@ func handlerGetTokenTransfers(c *fiber.Ctx) error {
 		params.BlockNumber,
 		params.StartBlockNumber,
 		params.EndBlockNumber,
+		startTimestamp,
+		endTimestamp,
 		params.TransactionHash,
 		params.TokenContractAddress,
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
+	// Set X-TOTAL-COUNT
+	// NOTE the maintained counter only exists for the unfiltered list
+	count, isEstimated := int64(0), false
+	if params.From == "" &&
+		params.To == "" &&
+		params.BlockNumber == 0 &&
+		params.StartBlockNumber == 0 &&
+		params.EndBlockNumber == 0 &&
+		startTimestamp == 0 &&
+		endTimestamp == 0 &&
+		params.TransactionHash == "" &&
+		params.TokenContractAddress == "" {
+		counter, err := crud.GetTransactionCountModel().SelectCount("token_transfer")
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		count = int64(counter)
+	} else {
+		count, isEstimated, err = crud.GetTokenTransferModel().CountMany(
+			params.From,
+			params.To,
+			params.BlockNumber,
+			params.StartBlockNumber,
+			params.EndBlockNumber,
+			startTimestamp,
+			endTimestamp,
+			params.TransactionHash,
+			params.TokenContractAddress,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
+
 	if len(*tokenTransfers) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
-	// Set X-TOTAL-COUNT
-	counter, err := crud.GetTransactionCountModel().SelectCount("token_transfer")
-	if err != nil {
-		counter = 0
-		zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
-	}
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(counter, 10))
-
 	body, _ := json.Marshal(&tokenTransfers)
 	return c.SendString(string(body))
 }
@@ -535,26 +1013,47 @@ func handlerGetTokenTransfers(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param address path string true "find by address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions/token-transfers/address/{address} [get]
 // @Success 200 {object} []models.TokenTransfer
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTokenTransfersAddress(c *fiber.Ctx) error {
 	address := c.Params("address")
 	if address == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "address required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		address := utils.CopyString(address)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTokenTransferModel().StreamManyByAddress(address, export.options, func(t *models.TokenTransfer) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -563,13 +1062,24 @@ func handlerGetTokenTransfersAddress(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Timestamps to block numbers
+	startBlockNumber, endBlockNumber, err := timestampRangeToBlockRange(startTimestamp, endTimestamp)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	// Get Transactions
@@ -577,28 +1087,45 @@ func handlerGetTokenTransfersAddress(c *fiber.Ctx) error {
 		params.Limit,
 		params.Skip,
 		address,
+		startBlockNumber,
+		endBlockNumber,
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
+	// Set X-TOTAL-COUNT
+	// Token transfer by address
+	// NOTE the maintained counter only exists for the unfiltered list
+	count, isEstimated := int64(0), false
+	if startBlockNumber == 0 && endBlockNumber == 0 {
+		counter, err := crud.GetTokenTransferCountByAddressModel().SelectCount(address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		count = int64(counter)
+	} else {
+		count, isEstimated, err = crud.GetTokenTransferCountByAddressIndexModel().CountByAddressBlockRange(
+			address,
+			startBlockNumber,
+			endBlockNumber,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
+
 	if len(*tokenTransfers) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
-	// Set X-TOTAL-COUNT
-	// Token transfer by address
-	count, err := crud.GetTokenTransferCountByAddressModel().SelectCount(address)
-	if err != nil {
-		count = 0
-		zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
-	}
-
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
-
 	body, _ := json.Marshal(&tokenTransfers)
 	return c.SendString(string(body))
 }
@@ -609,26 +1136,47 @@ func handlerGetTokenTransfersAddress(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param token_contract_address path string true "find by token contract address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
 // @Router /api/v1/transactions/token-transfers/token-contract/{token_contract_address} [get]
 // @Success 200 {object} []models.TokenTransfer
-// @Failure 422 {object} map[string]interface{}
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTokenTransfersTokenContract(c *fiber.Ctx) error {
 	tokenContractAddress := c.Params("token_contract_address")
 	if tokenContractAddress == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "token_contract_address required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		tokenContractAddress := utils.CopyString(tokenContractAddress)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTokenTransferModel().StreamManyByTokenContractAddress(tokenContractAddress, export.options, func(t *models.TokenTransfer) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -637,13 +1185,17 @@ func handlerGetTokenTransfersTokenContract(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
 	}
 
 	// Get Transactions
@@ -651,27 +1203,50 @@ func handlerGetTokenTransfersTokenContract(c *fiber.Ctx) error {
 		params.Limit,
 		params.Skip,
 		tokenContractAddress,
+		startTimestamp,
+		endTimestamp,
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
+	// X-TOTAL-COUNT
+	// NOTE the maintained counter only exists for the unfiltered list
+	count, isEstimated := int64(0), false
+	if startTimestamp == 0 && endTimestamp == 0 {
+		counter, err := crud.GetTokenTransferCountByTokenContractModel().SelectCount(tokenContractAddress)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		count = int64(counter)
+	} else {
+		count, isEstimated, err = crud.GetTokenTransferModel().CountMany(
+			"",
+			"",
+			0,
+			0,
+			0,
+			startTimestamp,
+			endTimestamp,
+			"",
+			tokenContractAddress,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			count, isEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+	setTotalCount(c, count, isEstimated)
+
 	if len(*tokenTransfers) == 0 {
 		// No Content
 		c.Status(204)
+		return nil
 	}
 
-	// X-TOTAL-COUNT
-	count, err := crud.GetTokenTransferCountByTokenContractModel().SelectCount(tokenContractAddress)
-	if err != nil {
-		count = 0
-		zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
-	}
-
-	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
-
 	body, _ := json.Marshal(&tokenTransfers)
 	return c.SendString(string(body))
 }
@@ -682,26 +1257,43 @@ func handlerGetTokenTransfersTokenContract(c *fiber.Ctx) error {
 // @Tags Transactions
 // @BasePath /api/v1
 // @Accept */*
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson
 // @Param limit query int false "amount of records"
 // @Param skip query int false "skip to a record"
 // @Param token_contract_address path string true "find by token contract address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
 // @Router /api/v1/transactions/token-holders/token-contract/{token_contract_address} [get]
-// @Success 200 {object} []models.TokenHolder
-// @Failure 422 {object} map[string]interface{}
+// @Success 200 {object} []RankedTokenHolder
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
 func handlerGetTokenHoldersTokenContract(c *fiber.Ctx) error {
 	tokenContractAddress := c.Params("token_contract_address")
 	if tokenContractAddress == "" {
-		c.Status(422)
-		return c.SendString(`{"error": "token_contract_address required"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
 	}
 
 	params := new(TransactionsQuery)
 	if err := c.QueryParser(params); err != nil {
 		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
 
-		c.Status(422)
-		return c.SendString(`{"error": "could not parse query parameters"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.TokenHolder{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		tokenContractAddress := utils.CopyString(tokenContractAddress)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetTokenHolderModel().StreamManyByTokenContractAddress(tokenContractAddress, export.options, func(t *models.TokenHolder) error {
+				return write(t)
+			})
+		})
 	}
 
 	// Default Params
@@ -710,13 +1302,11 @@ func handlerGetTokenHoldersTokenContract(c *fiber.Ctx) error {
 	}
 
 	// Check Params
-	if params.Limit < 1 || params.Limit > config.Config.MaxPageSize {
-		c.Status(422)
-		return c.SendString(`{"error": "limit must be greater than 0 and less than 101"}`)
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
 	}
 	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
-		c.Status(422)
-		return c.SendString(`{"error": "invalid skip"}`)
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
 	}
 
 	// Get Transactions
@@ -727,13 +1317,7 @@ func handlerGetTokenHoldersTokenContract(c *fiber.Ctx) error {
 	)
 	if err != nil {
 		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
-		c.Status(500)
-		return c.SendString(`{"error": "could not retrieve transactions"}`)
-	}
-
-	if len(*tokenHolders) == 0 {
-		// No Content
-		c.Status(204)
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
 	}
 
 	// X-TOTAL-COUNT
@@ -745,6 +1329,1338 @@ func handlerGetTokenHoldersTokenContract(c *fiber.Ctx) error {
 
 	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
 
-	body, _ := json.Marshal(&tokenHolders)
+	// Rank and share of supply
+	holderDistribution, err := selectHolderDistribution(tokenContractAddress)
+	if err != nil {
+		zap.S().Warn("Could not retrieve holder distribution: ", err.Error())
+	}
+	rankedTokenHolders := newRankedTokenHolders(tokenHolders, params.Skip, holderDistribution)
+
+	if len(*tokenHolders) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&rankedTokenHolders)
+	return c.SendString(string(body))
+}
+
+// TokenHoldersTokenContractDistribution
+// @Summary Get holder distribution by token contract
+// @Description get total supply and concentration metrics of token holders, computed daily
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param token_contract_address path string true "find by token contract address"
+// @Router /api/v1/transactions/token-holders/token-contract/{token_contract_address}/distribution [get]
+// @Success 200 {object} models.HolderDistribution
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 404 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTokenHoldersTokenContractDistribution(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+	if tokenContractAddress == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
+	}
+
+	return sendHolderDistribution(c, tokenContractAddress)
+}
+
+// IcxHolders
+// @Summary Get ICX rich list
+// @Description get addresses ranked by ICX balance, balances are refreshed daily
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Router /api/v1/transactions/icx-holders [get]
+// @Success 200 {object} []RankedIcxHolder
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetIcxHolders(c *fiber.Ctx) error {
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Get Balances
+	icxBalances, err := crud.GetIcxBalanceModel().SelectMany(
+		params.Limit,
+		params.Skip,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve icx holders"))
+	}
+
+	// X-TOTAL-COUNT
+	// NOTE holder count of the last computed distribution
+	holderDistribution, err := selectHolderDistribution(crud.IcxDistributionAddress)
+	if err != nil {
+		zap.S().Warn("Could not retrieve holder distribution: ", err.Error())
+	}
+	count := uint64(0)
+	if holderDistribution != nil {
+		count = holderDistribution.HolderCount
+	}
+
+	c.Append("X-TOTAL-COUNT", strconv.FormatUint(count, 10))
+
+	rankedIcxHolders := newRankedIcxHolders(icxBalances, params.Skip, holderDistribution)
+
+	if len(*icxBalances) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&rankedIcxHolders)
+	return c.SendString(string(body))
+}
+
+// IcxHoldersDistribution
+// @Summary Get ICX holder distribution
+// @Description get total supply and concentration metrics of ICX holders, computed daily
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Router /api/v1/transactions/icx-holders/distribution [get]
+// @Success 200 {object} models.HolderDistribution
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 404 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetIcxHoldersDistribution(c *fiber.Ctx) error {
+	return sendHolderDistribution(c, crud.IcxDistributionAddress)
+}
+
+// sendHolderDistribution - send the holder distribution or 404 if not computed yet
+func sendHolderDistribution(c *fiber.Ctx, tokenContractAddress string) error {
+	holderDistribution, err := selectHolderDistribution(tokenContractAddress)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve holder distribution"))
+	}
+	if holderDistribution == nil {
+		return apierrors.Send(c, apierrors.NotFound("holder distribution not found"))
+	}
+
+	body, _ := json.Marshal(holderDistribution)
+	return c.SendString(string(body))
+}
+
+// TokenHoldersTokenContractAtBlock
+// @Summary Get token holders by token contract at a block
+// @Description get historical token holder balances at a block height
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson return all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param token_contract_address path string true "find by token contract address"
+// @Param block_number path int true "block height"
+// @Router /api/v1/transactions/token-holders/token-contract/{token_contract_address}/at/{block_number} [get]
+// @Success 200 {object} []models.TokenHolder
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTokenHoldersTokenContractAtBlock(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+	if tokenContractAddress == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
+	}
+
+	blockNumber, err := strconv.ParseUint(c.Params("block_number"), 10, 64)
+	if err != nil || blockNumber == 0 {
+		return apierrors.Send(c, apierrors.InvalidParameter("block_number", "block_number must be an integer"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	tokenContractAddress = utils.CopyString(tokenContractAddress)
+
+	return sendTokenHolders(
+		c,
+		params,
+		func(limit int, skip int) (*[]models.TokenHolder, error) {
+			return crud.GetTokenHolderCheckpointModel().SelectManyByTokenContractAddressAtBlock(
+				limit,
+				skip,
+				tokenContractAddress,
+				blockNumber,
+			)
+		},
+		func() (int64, error) {
+			return crud.GetTokenHolderCheckpointModel().CountByTokenContractAddressAtBlock(tokenContractAddress, blockNumber)
+		},
+	)
+}
+
+// TokenHoldersAddressAtBlock
+// @Summary Get token balances by address at a block
+// @Description get historical token balances of an address at a block height
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson return all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param token_contract_address query string false "find by token contract address"
+// @Param address path string true "find by holder address"
+// @Param block_number path int true "block height"
+// @Router /api/v1/transactions/token-holders/address/{address}/at/{block_number} [get]
+// @Success 200 {object} []models.TokenHolder
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTokenHoldersAddressAtBlock(c *fiber.Ctx) error {
+	address := c.Params("address")
+	if address == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
+	}
+
+	blockNumber, err := strconv.ParseUint(c.Params("block_number"), 10, 64)
+	if err != nil || blockNumber == 0 {
+		return apierrors.Send(c, apierrors.InvalidParameter("block_number", "block_number must be an integer"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	address = utils.CopyString(address)
+
+	return sendTokenHolders(
+		c,
+		params,
+		func(limit int, skip int) (*[]models.TokenHolder, error) {
+			return crud.GetTokenHolderCheckpointModel().SelectManyByHolderAddressAtBlock(
+				limit,
+				skip,
+				address,
+				params.TokenContractAddress,
+				blockNumber,
+			)
+		},
+		func() (int64, error) {
+			return crud.GetTokenHolderCheckpointModel().CountByHolderAddressAtBlock(address, params.TokenContractAddress, blockNumber)
+		},
+	)
+}
+
+// sendTokenHolders - write a json page or a full export of token holders
+// NOTE selectMany and count run after the handler returns for exports
+func sendTokenHolders(
+	c *fiber.Ctx,
+	params *TransactionsQuery,
+	selectMany func(limit int, skip int) (*[]models.TokenHolder, error),
+	count func() (int64, error),
+) error {
+
+	// Export
+	export, err := newExport(c, params, &models.TokenHolder{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		return export.send(c, func(write func(row interface{}) error) error {
+			tokenHolders, err := selectMany(export.options.Limit, 0)
+			if err != nil {
+				return err
+			}
+
+			for i := range *tokenHolders {
+				err := write(&(*tokenHolders)[i])
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Get Token Holders
+	tokenHolders, err := selectMany(params.Limit, params.Skip)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve token holders"))
+	}
+
+	if len(*tokenHolders) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	// X-TOTAL-COUNT
+	tokenHoldersCount, err := count()
+	if err != nil {
+		tokenHoldersCount = 0
+		zap.S().Warn("Could not retrieve token holders count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(tokenHoldersCount, 10))
+
+	body, _ := json.Marshal(tokenHolders)
+	return c.SendString(string(body))
+}
+
+// MultiTokenTransfers
+// @Summary Get IRC-31 token transfers
+// @Description get historical IRC-31 (multi token) transfers
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param from query string false "find by from address"
+// @Param to query string false "find by to address"
+// @Param block_number query int false "find by block number"
+// @Param start_block_number query int false "find by block number range"
+// @Param end_block_number query int false "find by block number range"
+// @Param token_contract_address query string false "find by token contract"
+// @Param token_id query string false "find by token id"
+// @Param transaction_hash query string false "find by transaction hash"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/token-transfers/irc31 [get]
+// @Success 200 {object} []models.MultiTokenTransfer
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetMultiTokenTransfers(c *fiber.Ctx) error {
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.MultiTokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetMultiTokenTransferModel().StreamMany(params.From, params.To, params.BlockNumber, params.TransactionHash, params.TokenContractAddress, params.TokenID, export.options, func(t *models.MultiTokenTransfer) error {
+				return write(t)
+			})
+		})
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Transactions
+	multiTokenTransfers, err := crud.GetMultiTokenTransferModel().SelectMany(
+		params.Limit,
+		params.Skip,
+		params.From,
+		params.To,
+		params.BlockNumber,
+		params.StartBlockNumber,
+		params.EndBlockNumber,
+		startTimestamp,
+		endTimestamp,
+		params.TransactionHash,
+		params.TokenContractAddress,
+		params.TokenID,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
+	}
+
+	if len(*multiTokenTransfers) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&multiTokenTransfers)
+	return c.SendString(string(body))
+}
+
+// MultiTokenTransfersAddress
+// @Summary Get IRC-31 token transfers by address
+// @Description get historical IRC-31 (multi token) transfers by from or to address
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param address path string true "find by address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/token-transfers/irc31/address/{address} [get]
+// @Success 200 {object} []models.MultiTokenTransfer
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetMultiTokenTransfersAddress(c *fiber.Ctx) error {
+	address := c.Params("address")
+	if address == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.MultiTokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		address := utils.CopyString(address)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetMultiTokenTransferModel().StreamManyByAddress(address, export.options, func(t *models.MultiTokenTransfer) error {
+				return write(t)
+			})
+		})
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Transactions
+	multiTokenTransfers, err := crud.GetMultiTokenTransferModel().SelectManyByAddress(
+		params.Limit,
+		params.Skip,
+		address,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetMultiTokenTransferModel().CountByAddress(address)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve multi token transfer count: ", err.Error())
+	}
+
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*multiTokenTransfers) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&multiTokenTransfers)
+	return c.SendString(string(body))
+}
+
+// MultiTokenTransfersTokenContract
+// @Summary Get IRC-31 token transfers by token contract
+// @Description get historical IRC-31 (multi token) transfers by token contract
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param token_id query string false "find by token id"
+// @Param token_contract_address path string true "find by token contract address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Param start_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by block timestamp range, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/token-transfers/irc31/token-contract/{token_contract_address} [get]
+// @Success 200 {object} []models.MultiTokenTransfer
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetMultiTokenTransfersTokenContract(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+	if tokenContractAddress == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.MultiTokenTransfer{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		tokenContractAddress := utils.CopyString(tokenContractAddress)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetMultiTokenTransferModel().StreamManyByTokenContractAddress(tokenContractAddress, params.TokenID, export.options, func(t *models.MultiTokenTransfer) error {
+				return write(t)
+			})
+		})
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Transactions
+	multiTokenTransfers, err := crud.GetMultiTokenTransferModel().SelectManyByTokenContractAddress(
+		params.Limit,
+		params.Skip,
+		tokenContractAddress,
+		params.TokenID,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve transactions"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetMultiTokenTransferModel().CountByTokenContract(tokenContractAddress, params.TokenID)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve multi token transfer count: ", err.Error())
+	}
+
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*multiTokenTransfers) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&multiTokenTransfers)
+	return c.SendString(string(body))
+}
+
+// MultiTokenHoldersTokenContract
+// @Summary Get IRC-31 token holders by token contract
+// @Description get IRC-31 (multi token) balances per token id and holder
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json,text/csv,application/x-ndjson
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param token_id query string false "find by token id"
+// @Param token_contract_address path string true "find by token contract address"
+// @Param format query string false "json (default), csv, or ndjson, csv and ndjson stream all records"
+// @Param columns query string false "comma separated columns for csv and ndjson"
+// @Param start_block_number query int false "find by block number range, csv and ndjson only"
+// @Param end_block_number query int false "find by block number range, csv and ndjson only"
+// @Router /api/v1/transactions/token-transfers/irc31/token-contract/{token_contract_address}/holders [get]
+// @Success 200 {object} []models.MultiTokenHolder
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetMultiTokenHoldersTokenContract(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+	if tokenContractAddress == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("token_contract_address", "token_contract_address required"))
+	}
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Transactions Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Export
+	export, err := newExport(c, params, &models.MultiTokenHolder{})
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+	if export != nil {
+		tokenContractAddress := utils.CopyString(tokenContractAddress)
+		return export.send(c, func(write func(row interface{}) error) error {
+			return crud.GetMultiTokenHolderModel().StreamManyByTokenContractAddress(tokenContractAddress, params.TokenID, export.options, func(t *models.MultiTokenHolder) error {
+				return write(t)
+			})
+		})
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Get Holders
+	multiTokenHolders, err := crud.GetMultiTokenHolderModel().SelectManyByTokenContractAddress(
+		params.Limit,
+		params.Skip,
+		tokenContractAddress,
+		params.TokenID,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve token holders"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetMultiTokenHolderModel().CountByTokenContract(tokenContractAddress, params.TokenID)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve multi token holder count: ", err.Error())
+	}
+
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*multiTokenHolders) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&multiTokenHolders)
+	return c.SendString(string(body))
+}
+
+// Contracts
+// @Summary Get contracts
+// @Description get deployed contracts and their audit status
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param deployer query string false "find by deployer address"
+// @Param status query string false "pending, accepted, or rejected"
+// @Router /api/v1/transactions/contracts [get]
+// @Success 200 {object} []models.Contract
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetContracts(c *fiber.Ctx) error {
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Contracts Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+	if params.Status != "" && params.Status != "pending" && params.Status != "accepted" && params.Status != "rejected" {
+		return apierrors.Send(c, apierrors.InvalidParameter("status", "status must be pending, accepted, or rejected"))
+	}
+
+	// Get Contracts
+	contracts, err := crud.GetContractModel().SelectMany(
+		params.Limit,
+		params.Skip,
+		params.Deployer,
+		params.Status,
+	)
+	if err != nil {
+		zap.S().Warnf("Contracts CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve contracts"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetContractModel().CountMany(params.Deployer, params.Status)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve contract count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*contracts) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&contracts)
+	return c.SendString(string(body))
+}
+
+// ContractDetail - contract with its deploy history
+type ContractDetail struct {
+	*models.Contract
+
+	Updates *[]models.ContractUpdate `json:"updates"`
+}
+
+// Contract
+// @Summary Get contract
+// @Description get a deployed contract with its deploy history
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param address path string true "contract address"
+// @Router /api/v1/transactions/contracts/{address} [get]
+// @Success 200 {object} ContractDetail
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 404 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetContract(c *fiber.Ctx) error {
+	address := c.Params("address")
+
+	if address == "" {
+		return apierrors.Send(c, apierrors.InvalidParameter("address", "address required"))
+	}
+
+	contract, err := crud.GetContractModel().SelectOne(address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return apierrors.Send(c, apierrors.NotFound("no contract found"))
+	} else if err != nil {
+		zap.S().Warnf("Contracts CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve contract"))
+	}
+
+	// Deploy history
+	contractUpdates, err := crud.GetContractModel().SelectManyUpdates(address)
+	if err != nil {
+		zap.S().Warnf("Contracts CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve contract"))
+	}
+
+	body, _ := json.Marshal(&ContractDetail{
+		Contract: contract,
+		Updates:  contractUpdates,
+	})
+	return c.SendString(string(body))
+}
+
+// Daily Stats
+// @Summary Get daily stats
+// @Description get transaction, internal transaction, token transfer, active address, value, and fee totals per day
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/stats/daily [get]
+// @Success 200 {object} []models.TransactionStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTransactionStatsDaily(c *fiber.Ctx) error {
+	return sendTransactionStats(c, "daily")
+}
+
+// Hourly Stats
+// @Summary Get hourly stats
+// @Description get transaction, internal transaction, token transfer, active address, value, and fee totals per hour
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/stats/hourly [get]
+// @Success 200 {object} []models.TransactionStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTransactionStatsHourly(c *fiber.Ctx) error {
+	return sendTransactionStats(c, "hourly")
+}
+
+func sendTransactionStats(c *fiber.Ctx, period string) error {
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Stats
+	transactionStats, err := crud.GetTransactionStatModel().SelectMany(
+		period,
+		params.Limit,
+		params.Skip,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve stats"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetTransactionStatModel().CountMany(period, startTimestamp, endTimestamp)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve stats count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*transactionStats) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&transactionStats)
+	return c.SendString(string(body))
+}
+
+// Fee Stats
+// @Summary Get fee stats
+// @Description get fees, steps used, and step prices per day or hour
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param period query string false "daily (default) or hourly"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/stats/fees [get]
+// @Success 200 {object} []FeeStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetFeeStats(c *fiber.Ctx) error {
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+	if params.Period == "" {
+		params.Period = "daily"
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+	if params.Period != "daily" && params.Period != "hourly" {
+		return apierrors.Send(c, apierrors.InvalidParameter("period", "period must be daily or hourly"))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Stats
+	transactionStats, err := crud.GetTransactionStatModel().SelectMany(
+		params.Period,
+		params.Limit,
+		params.Skip,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve stats"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetTransactionStatModel().CountMany(params.Period, startTimestamp, endTimestamp)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve stats count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	feeStats := newFeeStats(transactionStats)
+
+	if len(*transactionStats) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&feeStats)
+	return c.SendString(string(body))
+}
+
+// Address Fee Stats
+// @Summary Get fee stats by address
+// @Description get fees paid and steps used per day by a sender
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param address path string true "find by sender address"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/address/{address}/fees [get]
+// @Success 200 {object} []models.AddressFeeStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetAddressFeeStats(c *fiber.Ctx) error {
+	address := c.Params("address")
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Fee Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Stats
+	addressFeeStats, err := crud.GetFeeStatModel().SelectManyByAddress(
+		address,
+		params.Limit,
+		params.Skip,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Fee Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve fee stats"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetFeeStatModel().CountManyByAddress(address, startTimestamp, endTimestamp)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve fee stats count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*addressFeeStats) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&addressFeeStats)
+	return c.SendString(string(body))
+}
+
+// Contract Fee Stats
+// @Summary Get fee stats by contract
+// @Description get fees paid and steps used per day by callers of a contract
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param address path string true "find by contract address"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/contracts/{address}/fees [get]
+// @Success 200 {object} []models.ContractFeeStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetContractFeeStats(c *fiber.Ctx) error {
+	contractAddress := c.Params("address")
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Fee Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Stats
+	contractFeeStats, err := crud.GetFeeStatModel().SelectManyByContract(
+		contractAddress,
+		params.Limit,
+		params.Skip,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Fee Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve fee stats"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetFeeStatModel().CountManyByContract(contractAddress, startTimestamp, endTimestamp)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve fee stats count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*contractFeeStats) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&contractFeeStats)
+	return c.SendString(string(body))
+}
+
+// Token Contract Stats
+// @Summary Get token contract stats
+// @Description get daily transfer count, volume, unique senders and receivers, and holder count of a token contract
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param skip query int false "skip to a record"
+// @Param token_contract_address path string true "find by token contract address"
+// @Param start_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "find by bucket start, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/token-contracts/{token_contract_address}/stats [get]
+// @Success 200 {object} []models.TokenContractStat
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTokenContractStats(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Token Contract Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+	if params.Skip < 0 || params.Skip > config.Config.MaxPageSkip {
+		return apierrors.Send(c, apierrors.InvalidParameter("skip", "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip)))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Get Stats
+	tokenContractStats, err := crud.GetTokenContractStatModel().SelectMany(
+		tokenContractAddress,
+		params.Limit,
+		params.Skip,
+		startTimestamp,
+		endTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve stats"))
+	}
+
+	// X-TOTAL-COUNT
+	count, err := crud.GetTokenContractStatModel().CountMany(tokenContractAddress, startTimestamp, endTimestamp)
+	if err != nil {
+		count = 0
+		zap.S().Warn("Could not retrieve stats count: ", err.Error())
+	}
+	c.Append("X-TOTAL-COUNT", strconv.FormatInt(count, 10))
+
+	if len(*tokenContractStats) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&tokenContractStats)
+	return c.SendString(string(body))
+}
+
+// Token Contract Top Movers
+// @Summary Get token contract top movers
+// @Description get the addresses with the most value sent and received by a token contract in a window, defaulted to the last day
+// @Tags Transactions
+// @BasePath /api/v1
+// @Accept */*
+// @Produce json
+// @Param limit query int false "amount of records"
+// @Param token_contract_address path string true "find by token contract address"
+// @Param start_timestamp query string false "window start, unix micro seconds or RFC3339"
+// @Param end_timestamp query string false "window end, unix micro seconds or RFC3339"
+// @Router /api/v1/transactions/token-contracts/{token_contract_address}/stats/top-movers [get]
+// @Success 200 {object} []crud.TokenContractMover
+// @Failure 401 {object} apierrors.Envelope
+// @Failure 422 {object} apierrors.Envelope
+// @Failure 429 {object} apierrors.Envelope
+// @Failure 500 {object} apierrors.Envelope
+func handlerGetTokenContractTopMovers(c *fiber.Ctx) error {
+	tokenContractAddress := c.Params("token_contract_address")
+
+	params := new(TransactionsQuery)
+	if err := c.QueryParser(params); err != nil {
+		zap.S().Warnf("Token Contract Stats Get Handler ERROR: %s", err.Error())
+
+		return apierrors.Send(c, apierrors.InvalidParameter("", "could not parse query parameters"))
+	}
+
+	// Default Params
+	if params.Limit <= 0 {
+		params.Limit = 25
+	}
+
+	// Check Params
+	if params.Limit < 1 || params.Limit > limits.MaxPageSize(c) {
+		return apierrors.Send(c, apierrors.InvalidParameter("limit", "limit must be between 1 and "+strconv.Itoa(limits.MaxPageSize(c))))
+	}
+
+	// Timestamps
+	startTimestamp, endTimestamp, err := parseTimestampRange(params)
+	if err != nil {
+		return apierrors.Send(c, err)
+	}
+
+	// Window
+	day := int64(24 * time.Hour / time.Microsecond)
+	if endTimestamp == 0 {
+		endTimestamp = time.Now().UnixNano() / 1000
+	}
+	if startTimestamp == 0 {
+		startTimestamp = endTimestamp - day
+	}
+	if endTimestamp < startTimestamp || endTimestamp-startTimestamp > 31*day {
+		return apierrors.Send(c, apierrors.InvalidParameter("start_timestamp", "window must be at most 31 days"))
+	}
+
+	// Get Movers
+	tokenContractMovers, err := crud.GetTokenContractStatModel().SelectTopMovers(
+		tokenContractAddress,
+		startTimestamp,
+		endTimestamp,
+		params.Limit,
+	)
+	if err != nil {
+		zap.S().Warnf("Stats CRUD ERROR: %s", err.Error())
+		return apierrors.Send(c, apierrors.Internal("could not retrieve top movers"))
+	}
+
+	if len(*tokenContractMovers) == 0 {
+		// No Content
+		c.Status(204)
+		return nil
+	}
+
+	body, _ := json.Marshal(&tokenContractMovers)
 	return c.SendString(string(body))
 }
@@ -0,0 +1,48 @@
+//+build unit
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestNewTransactionDetailsBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	transactions := &[]models.TransactionAPIDetail{
+		{Hash: "0x2"},
+		{Hash: "0x1"},
+	}
+	internalTransactions := &[]models.TransactionInternalAPIList{
+		{Hash: "0x1", Value: "0xa"},
+		{Hash: "0x1", Value: "0xb"},
+	}
+	tokenTransfers := &[]models.TokenTransfer{
+		{TransactionHash: "0x2", LogIndex: 0},
+	}
+
+	batch := newTransactionDetailsBatch(
+		[]string{"0x1", "0x3", "0x2"},
+		transactions,
+		internalTransactions,
+		tokenTransfers,
+	)
+
+	// Request order
+	assert.Equal(2, len(batch.Transactions))
+	assert.Equal("0x1", batch.Transactions[0].Hash)
+	assert.Equal("0x2", batch.Transactions[1].Hash)
+
+	// Related rows
+	assert.Equal(2, len(*batch.Transactions[0].InternalTransactions))
+	assert.Equal(0, len(*batch.Transactions[0].TokenTransfers))
+	assert.Equal(0, len(*batch.Transactions[1].InternalTransactions))
+	assert.Equal(1, len(*batch.Transactions[1].TokenTransfers))
+
+	// Not found
+	assert.Equal([]string{"0x3"}, batch.NotFound)
+}
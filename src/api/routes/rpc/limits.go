@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// Metadata with the api key, like the X-API-KEY header of the rest endpoints
+const metadataAPIKey = "x-api-key"
+
+type limitsContextKey struct{}
+
+// limitsUnaryInterceptor - check the api key and rate limit of a request, see limits.New
+func limitsUnaryInterceptor(
+	ctx context.Context,
+	request interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	ctx, err := checkLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+// limitsStreamInterceptor - check the api key and rate limit of a stream when it starts
+func limitsStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	_, err := checkLimits(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// checkLimits - context with the limits of a request
+// NOTE requests without an api key are limited by the ip of the peer, sharing the bucket of the rest endpoints
+func checkLimits(ctx context.Context) (context.Context, error) {
+
+	apiKey := ""
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		values := md.Get(metadataAPIKey)
+		if len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+
+	ip := ""
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		ip = p.Addr.String()
+
+		host, _, err := net.SplitHostPort(ip)
+		if err == nil {
+			ip = host
+		}
+	}
+
+	requestLimits, err := limits.Check(apiKey, ip)
+	if errors.Is(err, limits.ErrRateLimited) {
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	} else if errors.Is(err, limits.ErrInvalidAPIKey) {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	} else if err != nil {
+		zap.S().Warn("Limits ERROR: ", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve api key")
+	}
+
+	return context.WithValue(ctx, limitsContextKey{}, requestLimits), nil
+}
+
+// maxPageSize - max limit param of a request
+func maxPageSize(ctx context.Context) int {
+	requestLimits, ok := ctx.Value(limitsContextKey{}).(*limits.Limits)
+	if ok == false {
+		return config.Config.MaxPageSize
+	}
+
+	return requestLimits.MaxPageSize
+}
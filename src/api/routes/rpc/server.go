@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// server - grpc version of the rest endpoints
+type server struct {
+	models.UnimplementedTransactionsServiceServer
+}
+
+func newServer() *grpc.Server {
+	// NOTE api keys and rate limits are checked like the rest endpoints
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(limitsUnaryInterceptor),
+		grpc.StreamInterceptor(limitsStreamInterceptor),
+	)
+
+	models.RegisterTransactionsServiceServer(s, &server{})
+
+	// Reflection for debugging with grpcurl
+	reflection.Register(s)
+
+	return s
+}
+
+func Start() {
+
+	listener, err := net.Listen("tcp", ":"+config.Config.GRPCPort)
+	if err != nil {
+		zap.S().Fatal("Unable to start grpc server: ", err.Error())
+	}
+
+	go newServer().Serve(listener)
+	zap.S().Info("Started gRPC:", config.Config.GRPCPort)
+}
+
+// checkPage - default and check limit and skip like the rest endpoints
+// NOTE the max limit is set by the tier of the api key
+// Returns: limit, skip, error (if present)
+func checkPage(ctx context.Context, limit int32, skip int32) (int, int, error) {
+
+	// Default Params
+	if limit <= 0 {
+		limit = 25
+	}
+
+	// Check Params
+	maxLimit := maxPageSize(ctx)
+	if int(limit) > maxLimit {
+		return 0, 0, status.Error(codes.InvalidArgument, "limit must be between 1 and "+strconv.Itoa(maxLimit))
+	}
+	if skip < 0 || int(skip) > config.Config.MaxPageSkip {
+		return 0, 0, status.Error(codes.InvalidArgument, "skip must be between 0 and "+strconv.Itoa(config.Config.MaxPageSkip))
+	}
+
+	return int(limit), int(skip), nil
+}
@@ -0,0 +1,189 @@
+//+build unit
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/geometry-labs/icon-transactions/api/routes/limits"
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
+)
+
+func init() {
+	config.ReadEnvironment()
+
+	// NOTE no redis
+	config.Config.RateLimitEnabled = false
+}
+
+func newTestConn(t *testing.T) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+
+	s := newServer()
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestServerInvalidArgument(t *testing.T) {
+	assert := assert.New(t)
+
+	client := models.NewTransactionsServiceClient(newTestConn(t))
+	ctx := context.Background()
+
+	// Checked before any query, no database
+	_, err := client.GetTransactions(ctx, &models.GetTransactionsRequest{Limit: int32(config.Config.MaxPageSize + 1)})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetTransactions(ctx, &models.GetTransactionsRequest{Skip: -1})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetTransactions(ctx, &models.GetTransactionsRequest{Status: "pending"})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	assert.Equal("status must be success or failed", status.Convert(err).Message())
+
+	_, err = client.GetTransactionDetails(ctx, &models.GetTransactionDetailsRequest{})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetTransactionsByAddress(ctx, &models.GetTransactionsByAddressRequest{})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetTokenHoldersByTokenContract(ctx, &models.GetTokenHoldersByTokenContractRequest{})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServerReflection(t *testing.T) {
+	assert := assert.New(t)
+
+	client := reflectionpb.NewServerReflectionClient(newTestConn(t))
+
+	stream, err := client.ServerReflectionInfo(context.Background())
+	assert.Equal(nil, err)
+
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	assert.Equal(nil, err)
+
+	response, err := stream.Recv()
+	assert.Equal(nil, err)
+
+	services := []string{}
+	for _, service := range response.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	assert.Contains(services, "models.TransactionsService")
+}
+
+func TestServerSubscribeTransactions(t *testing.T) {
+	assert := assert.New(t)
+
+	redis.GetBroadcaster().Start()
+
+	client := models.NewTransactionsServiceClient(newTestConn(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.SubscribeTransactions(ctx, &models.SubscribeTransactionsRequest{To: "cx1"})
+	assert.Equal(nil, err)
+
+	// Wait for the subscription
+	// NOTE headers are sent after the server handler starts
+	for i := 0; i < 50 && len(redis.GetBroadcaster().OutputChannels) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for _, transaction := range []*models.TransactionWebsocket{
+		{Hash: "0x1", ToAddress: "hx1"},
+		{Hash: "0x2", ToAddress: "cx1"},
+	} {
+		msg, _ := json.Marshal(transaction)
+		redis.GetBroadcaster().InputChannel <- msg
+	}
+
+	// Filtered
+	transaction, err := stream.Recv()
+	assert.Equal(nil, err)
+	assert.Equal("0x2", transaction.Hash)
+}
+
+func TestSubscribeFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	filter := newSubscribeFilter(&models.SubscribeTransactionsRequest{})
+	assert.Equal(true, filter.match(&models.TransactionWebsocket{FromAddress: "hx1", ToAddress: "hx2"}))
+
+	filter = newSubscribeFilter(&models.SubscribeTransactionsRequest{From: "hx1, hx3"})
+	assert.Equal(true, filter.match(&models.TransactionWebsocket{FromAddress: "hx3", ToAddress: "hx2"}))
+	assert.Equal(false, filter.match(&models.TransactionWebsocket{FromAddress: "hx2", ToAddress: "hx1"}))
+
+	filter = newSubscribeFilter(&models.SubscribeTransactionsRequest{From: "hx1", To: "cx1"})
+	assert.Equal(true, filter.match(&models.TransactionWebsocket{FromAddress: "hx1", ToAddress: "cx1"}))
+	assert.Equal(false, filter.match(&models.TransactionWebsocket{FromAddress: "hx1", ToAddress: "cx2"}))
+}
+
+func TestCheckPage(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx := context.Background()
+
+	limit, skip, err := checkPage(ctx, 0, 0)
+	assert.Equal(nil, err)
+	assert.Equal(25, limit)
+	assert.Equal(0, skip)
+
+	limit, skip, err = checkPage(ctx, 10, 20)
+	assert.Equal(nil, err)
+	assert.Equal(10, limit)
+	assert.Equal(20, skip)
+
+	_, _, err = checkPage(ctx, int32(config.Config.MaxPageSize+1), 0)
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Max limit of the tier
+	ctx = context.WithValue(ctx, limitsContextKey{}, &limits.Limits{MaxPageSize: config.Config.MaxPageSize + 10})
+
+	limit, _, err = checkPage(ctx, int32(config.Config.MaxPageSize+1), 0)
+	assert.Equal(nil, err)
+	assert.Equal(config.Config.MaxPageSize+1, limit)
+}
+
+func TestCheckLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	// Anonymous
+	ctx, err := checkLimits(context.Background())
+	assert.Equal(nil, err)
+	assert.Equal(config.Config.MaxPageSize, maxPageSize(ctx))
+
+	requestLimits, ok := ctx.Value(limitsContextKey{}).(*limits.Limits)
+	assert.Equal(true, ok)
+	assert.Equal("anonymous", requestLimits.Tier)
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
+)
+
+// Transactions buffered for a subscriber
+const subscribeBufferSize = 100
+
+func (s *server) SubscribeTransactions(
+	request *models.SubscribeTransactionsRequest,
+	stream models.TransactionsService_SubscribeTransactionsServer,
+) error {
+
+	filter := newSubscribeFilter(request)
+
+	// Add broadcaster
+	// NOTE buffered, the broadcaster drops channels blocked for a second
+	msgChan := make(chan []byte, subscribeBufferSize)
+	broadcasterID := redis.GetBroadcaster().AddBroadcastChannel(msgChan)
+	defer func() {
+		// Remove broadcaster
+		redis.GetBroadcaster().RemoveBroadcastChannel(broadcasterID)
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			// Client closed
+			return nil
+		case msg, ok := <-msgChan:
+			if ok == false {
+				// Dropped by the broadcaster
+				return status.Error(codes.ResourceExhausted, "subscriber too slow, transactions dropped")
+			}
+
+			transaction := &models.TransactionWebsocket{}
+			err := json.Unmarshal(msg, transaction)
+			if err != nil {
+				zap.S().Warn("Subscribe Transactions ERROR: ", err.Error())
+				continue
+			}
+
+			if filter.match(transaction) == false {
+				continue
+			}
+
+			// Send
+			err = stream.Send(transaction)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// subscribeFilter - addresses of a subscription, empty matches all
+type subscribeFilter struct {
+	from map[string]bool
+	to   map[string]bool
+}
+
+func newSubscribeFilter(request *models.SubscribeTransactionsRequest) *subscribeFilter {
+	filter := &subscribeFilter{
+		from: map[string]bool{},
+		to:   map[string]bool{},
+	}
+
+	for _, address := range splitList(request.From) {
+		filter.from[address] = true
+	}
+	for _, address := range splitList(request.To) {
+		filter.to[address] = true
+	}
+
+	return filter
+}
+
+func (f *subscribeFilter) match(transaction *models.TransactionWebsocket) bool {
+	if len(f.from) > 0 && f.from[transaction.FromAddress] == false {
+		return false
+	}
+	if len(f.to) > 0 && f.to[transaction.ToAddress] == false {
+		return false
+	}
+
+	return true
+}
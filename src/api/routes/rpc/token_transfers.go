@@ -0,0 +1,247 @@
+package rpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func (s *server) GetTokenTransfers(
+	ctx context.Context,
+	request *models.GetTokenTransfersRequest,
+) (*models.GetTokenTransfersResponse, error) {
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Token Transfers
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectMany(
+		limit,
+		skip,
+		request.From,
+		request.To,
+		int(request.BlockNumber),
+		int(request.StartBlockNumber),
+		int(request.EndBlockNumber),
+		request.StartTimestamp,
+		request.EndTimestamp,
+		request.TransactionHash,
+		request.TokenContractAddress,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve token transfers")
+	}
+
+	response := &models.GetTokenTransfersResponse{}
+	for i := range *tokenTransfers {
+		response.TokenTransfers = append(response.TokenTransfers, &(*tokenTransfers)[i])
+	}
+
+	// Total count
+	// NOTE the maintained counter only exists for the unfiltered list
+	if request.From == "" &&
+		request.To == "" &&
+		request.BlockNumber == 0 &&
+		request.StartBlockNumber == 0 &&
+		request.EndBlockNumber == 0 &&
+		request.StartTimestamp == 0 &&
+		request.EndTimestamp == 0 &&
+		request.TransactionHash == "" &&
+		request.TokenContractAddress == "" {
+		counter, err := crud.GetTransactionCountModel().SelectCount("token_transfer")
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		response.TotalCount = int64(counter)
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTokenTransferModel().CountMany(
+			request.From,
+			request.To,
+			int(request.BlockNumber),
+			int(request.StartBlockNumber),
+			int(request.EndBlockNumber),
+			request.StartTimestamp,
+			request.EndTimestamp,
+			request.TransactionHash,
+			request.TokenContractAddress,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func (s *server) GetTokenTransfersByAddress(
+	ctx context.Context,
+	request *models.GetTokenTransfersByAddressRequest,
+) (*models.GetTokenTransfersResponse, error) {
+
+	if request.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Token Transfers
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByAddress(
+		limit,
+		skip,
+		request.Address,
+		int(request.StartBlockNumber),
+		int(request.EndBlockNumber),
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve token transfers")
+	}
+
+	response := &models.GetTokenTransfersResponse{}
+	for i := range *tokenTransfers {
+		response.TokenTransfers = append(response.TokenTransfers, &(*tokenTransfers)[i])
+	}
+
+	// Total count
+	// NOTE the maintained counter only exists for the unfiltered list
+	if request.StartBlockNumber == 0 && request.EndBlockNumber == 0 {
+		counter, err := crud.GetTokenTransferCountByAddressModel().SelectCount(request.Address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		response.TotalCount = int64(counter)
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTokenTransferCountByAddressIndexModel().CountByAddressBlockRange(
+			request.Address,
+			int(request.StartBlockNumber),
+			int(request.EndBlockNumber),
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func (s *server) GetTokenTransfersByTokenContract(
+	ctx context.Context,
+	request *models.GetTokenTransfersByTokenContractRequest,
+) (*models.GetTokenTransfersResponse, error) {
+
+	if request.TokenContractAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "token_contract_address required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Token Transfers
+	tokenTransfers, err := crud.GetTokenTransferModel().SelectManyByTokenContractAddress(
+		limit,
+		skip,
+		request.TokenContractAddress,
+		request.StartTimestamp,
+		request.EndTimestamp,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve token transfers")
+	}
+
+	response := &models.GetTokenTransfersResponse{}
+	for i := range *tokenTransfers {
+		response.TokenTransfers = append(response.TokenTransfers, &(*tokenTransfers)[i])
+	}
+
+	// Total count
+	// NOTE the maintained counter only exists for the unfiltered list
+	if request.StartTimestamp == 0 && request.EndTimestamp == 0 {
+		counter, err := crud.GetTokenTransferCountByTokenContractModel().SelectCount(request.TokenContractAddress)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+		response.TotalCount = int64(counter)
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTokenTransferModel().CountMany(
+			"",
+			"",
+			0,
+			0,
+			0,
+			request.StartTimestamp,
+			request.EndTimestamp,
+			"",
+			request.TokenContractAddress,
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve token transfer count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func (s *server) GetTokenHoldersByTokenContract(
+	ctx context.Context,
+	request *models.GetTokenHoldersByTokenContractRequest,
+) (*models.GetTokenHoldersResponse, error) {
+
+	if request.TokenContractAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "token_contract_address required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Token Holders
+	tokenHolders, err := crud.GetTokenHolderModel().SelectManyByTokenContractAddress(
+		limit,
+		skip,
+		request.TokenContractAddress,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve token holders")
+	}
+
+	response := &models.GetTokenHoldersResponse{}
+	for i := range *tokenHolders {
+		response.TokenHolders = append(response.TokenHolders, &(*tokenHolders)[i])
+	}
+
+	// Total count
+	counter, err := crud.GetTokenHolderCountByTokenContractModel().SelectCount(request.TokenContractAddress)
+	if err != nil {
+		counter = 0
+		zap.S().Warn("Could not retrieve token holder count: ", err.Error())
+	}
+	response.TotalCount = int64(counter)
+
+	return response, nil
+}
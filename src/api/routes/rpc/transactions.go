@@ -0,0 +1,348 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func (s *server) GetTransactions(
+	ctx context.Context,
+	request *models.GetTransactionsRequest,
+) (*models.GetTransactionsResponse, error) {
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filters
+	filters, err := newTransactionFilters(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Transactions
+	transactions, err := crud.GetTransactionModel().SelectManyAPI(
+		limit,
+		skip,
+		filters,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve transactions")
+	}
+
+	response := &models.GetTransactionsResponse{}
+	for i := range *transactions {
+		response.Transactions = append(response.Transactions, &(*transactions)[i])
+	}
+
+	// Total count
+	// NOTE maintained counters only exist for unfiltered lists
+	if filters.IsEmpty() {
+		counterTypes := []string{"regular", "internal"}
+		if filters.Type == "transaction" {
+			counterTypes = []string{"regular"}
+		} else if filters.Type == "log" {
+			counterTypes = []string{"internal"}
+		}
+
+		for _, counterType := range counterTypes {
+			counter, err := crud.GetTransactionCountModel().SelectCount(counterType)
+			if err != nil {
+				counter = 0
+				zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+			}
+			response.TotalCount += int64(counter)
+		}
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTransactionModel().CountAPI(filters, config.Config.TotalCountCap)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func (s *server) GetTransactionDetails(
+	ctx context.Context,
+	request *models.GetTransactionDetailsRequest,
+) (*models.TransactionAPIDetail, error) {
+
+	if request.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash required")
+	}
+
+	transaction, err := crud.GetTransactionModel().SelectOneAPI(request.Hash, -1)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "no transaction found")
+	} else if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve transaction details")
+	}
+
+	return transaction, nil
+}
+
+func (s *server) GetTransactionsByBlockNumber(
+	ctx context.Context,
+	request *models.GetTransactionsByBlockNumberRequest,
+) (*models.GetTransactionsResponse, error) {
+
+	if request.BlockNumber <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "block_number required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Transactions
+	transactions, err := crud.GetTransactionModel().SelectManyAPI(
+		limit,
+		skip,
+		&crud.TransactionFilters{
+			BlockNumber: int(request.BlockNumber),
+			Sort:        "desc",
+		},
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve transactions")
+	}
+
+	response := &models.GetTransactionsResponse{}
+	for i := range *transactions {
+		response.Transactions = append(response.Transactions, &(*transactions)[i])
+	}
+
+	// Total count
+	response.TotalCount, err = crud.GetTransactionModel().CountByBlockNumber(int(request.BlockNumber))
+	if err != nil {
+		response.TotalCount = 0
+		zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+	}
+
+	return response, nil
+}
+
+func (s *server) GetTransactionsByAddress(
+	ctx context.Context,
+	request *models.GetTransactionsByAddressRequest,
+) (*models.GetTransactionsResponse, error) {
+
+	if request.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Transactions
+	transactions, err := crud.GetTransactionModel().SelectManyByAddressAPI(
+		limit,
+		skip,
+		request.Address,
+		int(request.StartBlockNumber),
+		int(request.EndBlockNumber),
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve transactions")
+	}
+
+	response := &models.GetTransactionsResponse{}
+	for i := range *transactions {
+		response.Transactions = append(response.Transactions, &(*transactions)[i])
+	}
+
+	// Total count
+	// NOTE the maintained counter only exists for the unfiltered list
+	if request.StartBlockNumber == 0 && request.EndBlockNumber == 0 {
+		counter, err := crud.GetTransactionCountByAddressModel().SelectCount(request.Address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+		response.TotalCount = int64(counter)
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTransactionCountByAddressIndexModel().CountByAddressBlockRange(
+			request.Address,
+			int(request.StartBlockNumber),
+			int(request.EndBlockNumber),
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func (s *server) GetInternalTransactionsByHash(
+	ctx context.Context,
+	request *models.GetInternalTransactionsByHashRequest,
+) (*models.GetInternalTransactionsResponse, error) {
+
+	if request.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Internal Transactions
+	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalAPI(
+		limit,
+		skip,
+		request.Hash,
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve internal transactions")
+	}
+
+	response := &models.GetInternalTransactionsResponse{}
+	for i := range *internalTransactions {
+		response.InternalTransactions = append(response.InternalTransactions, &(*internalTransactions)[i])
+	}
+
+	// NOTE no total count, same as the rest endpoint
+	return response, nil
+}
+
+func (s *server) GetInternalTransactionsByAddress(
+	ctx context.Context,
+	request *models.GetInternalTransactionsByAddressRequest,
+) (*models.GetInternalTransactionsResponse, error) {
+
+	if request.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address required")
+	}
+
+	limit, skip, err := checkPage(ctx, request.Limit, request.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get Internal Transactions
+	internalTransactions, err := crud.GetTransactionModel().SelectManyInternalByAddressAPI(
+		limit,
+		skip,
+		request.Address,
+		int(request.StartBlockNumber),
+		int(request.EndBlockNumber),
+	)
+	if err != nil {
+		zap.S().Warnf("Transactions CRUD ERROR: %s", err.Error())
+		return nil, status.Error(codes.Internal, "could not retrieve internal transactions")
+	}
+
+	response := &models.GetInternalTransactionsResponse{}
+	for i := range *internalTransactions {
+		response.InternalTransactions = append(response.InternalTransactions, &(*internalTransactions)[i])
+	}
+
+	// Total count
+	// NOTE the maintained counter only exists for the unfiltered list
+	if request.StartBlockNumber == 0 && request.EndBlockNumber == 0 {
+		counter, err := crud.GetTransactionInternalCountByAddressModel().SelectCount(request.Address)
+		if err != nil {
+			counter = 0
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+		response.TotalCount = int64(counter)
+	} else {
+		response.TotalCount, response.TotalCountEstimated, err = crud.GetTransactionInternalCountByAddressIndexModel().CountByAddressBlockRange(
+			request.Address,
+			int(request.StartBlockNumber),
+			int(request.EndBlockNumber),
+			config.Config.TotalCountCap,
+		)
+		if err != nil {
+			response.TotalCount, response.TotalCountEstimated = 0, false
+			zap.S().Warn("Could not retrieve transaction count: ", err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+// newTransactionFilters - check the transaction list filters
+// NOTE same rules as the rest filters
+func newTransactionFilters(request *models.GetTransactionsRequest) (*crud.TransactionFilters, error) {
+
+	// Status
+	if request.Status != "" && request.Status != "success" && request.Status != "failed" {
+		return nil, status.Error(codes.InvalidArgument, "status must be success or failed")
+	}
+
+	// Sort
+	sort := request.Sort
+	if sort != "desc" && sort != "asc" {
+		sort = "desc"
+	}
+	if request.SortBy != "" && request.SortBy != "value" && request.SortBy != "fee" && request.SortBy != "block_number" {
+		return nil, status.Error(codes.InvalidArgument, "sort_by must be value, fee, or block_number")
+	}
+
+	// NOTE: casting string types for type field
+	transactionType := request.Type
+	if transactionType == "regular" {
+		transactionType = "transaction"
+	} else if transactionType == "internal" {
+		transactionType = "log"
+	}
+
+	return &crud.TransactionFilters{
+		From:             splitList(request.From),
+		To:               splitList(request.To),
+		Address:          request.Address,
+		Type:             transactionType,
+		BlockNumber:      int(request.BlockNumber),
+		StartBlockNumber: int(request.StartBlockNumber),
+		EndBlockNumber:   int(request.EndBlockNumber),
+		StartTimestamp:   request.StartTimestamp,
+		EndTimestamp:     request.EndTimestamp,
+		Method:           splitList(request.Method),
+		Status:           request.Status,
+		Sort:             sort,
+		SortBy:           request.SortBy,
+	}, nil
+}
+
+// splitList - split a comma separated field
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
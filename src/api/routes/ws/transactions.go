@@ -49,7 +49,11 @@ func handlerGetTransactions(c *websocket.Conn) {
 
 	for {
 		// Read
-		msg := <-msgChan
+		msg, ok := <-msgChan
+		if ok == false {
+			// Dropped by the broadcaster
+			break
+		}
 
 		// Broadcast
 		err := c.WriteMessage(websocket.TextMessage, msg)
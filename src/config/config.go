@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -10,10 +11,20 @@ type configType struct {
 	Name        string `envconfig:"NAME" required:"false" default:"transactions-service"`
 	NetworkName string `envconfig:"NETWORK_NAME" required:"false" default:"mainnnet"`
 
+	// Networks served by one deployment, ex "mainnet,lisbon,berlin"
+	// NOTE each network runs in its own process, see the networks package
+	Networks []string `envconfig:"NETWORKS" required:"false" default:""`
+
+	// Gateway to the network processes
+	// NOTE responses are buffered by the gateway, exports larger than the body size fail
+	GatewayMaxResponseBodySize int `envconfig:"GATEWAY_MAX_RESPONSE_BODY_SIZE" required:"false" default:"268435456"`
+	GatewayTimeoutSeconds      int `envconfig:"GATEWAY_TIMEOUT_SECONDS" required:"false" default:"120"`
+
 	// Ports
 	Port        string `envconfig:"PORT" required:"false" default:"8000"`
 	HealthPort  string `envconfig:"HEALTH_PORT" required:"false" default:"8180"`
 	MetricsPort string `envconfig:"METRICS_PORT" required:"false" default:"9400"`
+	GRPCPort    string `envconfig:"GRPC_PORT" required:"false" default:"50051"`
 
 	// Prefix
 	RestPrefix      string `envconfig:"REST_PREFIX" required:"false" default:"/api/v1"`
@@ -25,6 +36,36 @@ type configType struct {
 	MaxPageSize int `envconfig:"MAX_PAGE_SIZE" required:"false" default:"100"`
 	MaxPageSkip int `envconfig:"MAX_PAGE_SKIP" required:"false" default:"1000000"`
 
+	// Hashes per batch details request
+	MaxBatchSize int `envconfig:"MAX_BATCH_SIZE" required:"false" default:"500"`
+
+	// Rows per csv and ndjson export, 0 for no limit
+	MaxExportSize int `envconfig:"MAX_EXPORT_SIZE" required:"false" default:"100000"`
+
+	// Rate limits, requests per second refilled up to the burst
+	// NOTE requests without an api key are limited by ip, page and export sizes default to the max above
+	RateLimitEnabled        bool    `envconfig:"RATE_LIMIT_ENABLED" required:"false" default:"true"`
+	RateLimitAnonymousRate  float64 `envconfig:"RATE_LIMIT_ANONYMOUS_RATE" required:"false" default:"5"`
+	RateLimitAnonymousBurst int64   `envconfig:"RATE_LIMIT_ANONYMOUS_BURST" required:"false" default:"20"`
+	ApiKeyCacheSeconds      int     `envconfig:"API_KEY_CACHE_SECONDS" required:"false" default:"60"`
+
+	// Response cache of the rest endpoints, new blocks invalidate entries
+	RestCacheEnabled    bool `envconfig:"REST_CACHE_ENABLED" required:"false" default:"true"`
+	RestCacheTTLSeconds int  `envconfig:"REST_CACHE_TTL_SECONDS" required:"false" default:"10"`
+
+	// Header with the client ip, set when behind a proxy
+	RestProxyHeader string `envconfig:"REST_PROXY_HEADER" required:"false" default:""`
+
+	// Interface of the rest api, "" for all
+	RestHost string `envconfig:"REST_HOST" required:"false" default:""`
+
+	// Filtered X-TOTAL-COUNT is estimated above this count
+	TotalCountCap int64 `envconfig:"TOTAL_COUNT_CAP" required:"false" default:"10000"`
+
+	// GraphQL query limits, cost is the estimated number of resolved fields
+	GraphQLMaxCost  int `envconfig:"GRAPHQL_MAX_COST" required:"false" default:"10000"`
+	GraphQLMaxDepth int `envconfig:"GRAPHQL_MAX_DEPTH" required:"false" default:"10"`
+
 	// Icon node service
 	IconNodeServiceURL string `envconfig:"ICON_NODE_SERVICE_URL" required:"false" default:"https://ctz.solidwallet.io/api/v3"`
 
@@ -79,6 +120,23 @@ type configType struct {
 	DbTimezone           string `envconfig:"DB_TIMEZONE" required:"false" default:"UTC"`
 	DbMaxIdleConnections int    `envconfig:"DB_MAX_IDLE_CONNECTIONS" required:"false" default:"2"`
 	DbMaxOpenConnections int    `envconfig:"DB_MAX_OPEN_CONNECTIONS" required:"false" default:"10"`
+	DbSchema             string `envconfig:"DB_SCHEMA" required:"false" default:""` // created if missing, "" for the default search path
+
+	// DB partitions
+	// NOTE transactions, token_transfers and the count by address index tables, by block number
+	DbPartitionSize         uint64 `envconfig:"DB_PARTITION_SIZE" required:"false" default:"0"` // blocks per partition, 0 to disable, do not change once partitioned
+	DbPartitionsAhead       uint64 `envconfig:"DB_PARTITIONS_AHEAD" required:"false" default:"2"`
+	DbPartitionBatchSize    uint64 `envconfig:"DB_PARTITION_BATCH_SIZE" required:"false" default:"10000"` // blocks copied at a time when partitioning a table
+	DbPartitionCheckSeconds int    `envconfig:"DB_PARTITION_CHECK_SECONDS" required:"false" default:"60"`
+
+	// DB migrations
+	DbMigrateOnStart bool `envconfig:"DB_MIGRATE_ON_START" required:"false" default:"false"` // else services refuse to start with pending migrations
+
+	// DB read replicas
+	// NOTE host or host:port, with the credentials of the primary
+	DbReadHosts              []string `envconfig:"DB_READ_HOSTS" required:"false" default:""`
+	DbReadHealthCheckSeconds int      `envconfig:"DB_READ_HEALTH_CHECK_SECONDS" required:"false" default:"5"`
+	DbReadMaxLagSeconds      int      `envconfig:"DB_READ_MAX_LAG_SECONDS" required:"false" default:"0"` // replicas lagging more use the primary, 0 for no limit
 
 	// Redis
 	RedisHost                     string `envconfig:"REDIS_HOST" required:"false" default:"redis"`
@@ -87,10 +145,28 @@ type configType struct {
 	RedisChannel                  string `envconfig:"REDIS_CHANNEL" required:"false" default:"transactions"`
 	RedisSentinelClientMode       bool   `envconfig:"REDIS_SENTINEL_CLIENT_MODE" required:"false" default:"false"`
 	RedisSentinelClientMasterName string `envconfig:"REDIS_SENTINEL_CLIENT_MASTER_NAME" required:"false" default:"master"`
+	RedisKeyPrefix                string `envconfig:"REDIS_KEY_PREFIX" required:"false" default:"icon_transactions_"`
 
 	// GORM
 	GormLoggingThresholdMilli int `envconfig:"GORM_LOGGING_THRESHOLD_MILLI" required:"false" default:"250"`
 
+	// Routines
+	TokenHolderCheckpointInterval uint64 `envconfig:"TOKEN_HOLDER_CHECKPOINT_INTERVAL" required:"false" default:"100000"`
+	TokenHoldersMaxLagBlocks      uint64 `envconfig:"TOKEN_HOLDERS_MAX_LAG_BLOCKS" required:"false" default:"10"` // token holders are not reconciled while the loader lags the node more
+
+	// Routine schedules, cron expressions or descriptors, ex "*/30 * * * *" or "@daily"
+	RoutineTransactionCountSchedule                  string `envconfig:"ROUTINE_TRANSACTION_COUNT_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTransactionCountByAddressSchedule         string `envconfig:"ROUTINE_TRANSACTION_COUNT_BY_ADDRESS_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTransactionInternalCountByAddressSchedule string `envconfig:"ROUTINE_TRANSACTION_INTERNAL_COUNT_BY_ADDRESS_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTokenTransferCountByAddressSchedule       string `envconfig:"ROUTINE_TOKEN_TRANSFER_COUNT_BY_ADDRESS_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTokenTransferCountByTokenContractSchedule string `envconfig:"ROUTINE_TOKEN_TRANSFER_COUNT_BY_TOKEN_CONTRACT_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTokenHoldersSchedule                      string `envconfig:"ROUTINE_TOKEN_HOLDERS_SCHEDULE" required:"false" default:"@daily"`
+	RoutineTokenHolderCountByTokenContractSchedule   string `envconfig:"ROUTINE_TOKEN_HOLDER_COUNT_BY_TOKEN_CONTRACT_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineTokenHolderCheckpointsSchedule            string `envconfig:"ROUTINE_TOKEN_HOLDER_CHECKPOINTS_SCHEDULE" required:"false" default:"@hourly"`
+	RoutineHolderDistributionsSchedule               string `envconfig:"ROUTINE_HOLDER_DISTRIBUTIONS_SCHEDULE" required:"false" default:"@daily"`
+	RoutineIcxBalancesSchedule                       string `envconfig:"ROUTINE_ICX_BALANCES_SCHEDULE" required:"false" default:"@daily"`
+	RoutineTransactionMissingSchedule                string `envconfig:"ROUTINE_TRANSACTION_MISSING_SCHEDULE" required:"false" default:"@hourly"`
+
 	// Feature flags
 	OnlyRunAllRoutines bool `envconfig:"ONLY_RUN_ALL_ROUTINES" required:"false" default:"false"`
 	OnlyRunBackfill    bool `envconfig:"ONLY_RUN_BACKFILL" required:"false" default:"false"`
@@ -103,4 +179,16 @@ func ReadEnvironment() {
 	if err != nil {
 		log.Fatalf("ERROR: envconfig - %s\n", err.Error())
 	}
+
+	// Network scoped variables, ex LISBON_KAFKA_BROKER_URL for NETWORK_NAME=lisbon
+	// NOTE unset network scoped variables fall back to the unscoped ones
+	err = envconfig.Process(NetworkEnvPrefix(Config.NetworkName), &Config)
+	if err != nil {
+		log.Fatalf("ERROR: envconfig - %s\n", err.Error())
+	}
+}
+
+// NetworkEnvPrefix - prefix of the network scoped variables of a network
+func NetworkEnvPrefix(network string) string {
+	return strings.ToUpper(strings.ReplaceAll(network, "-", "_"))
 }
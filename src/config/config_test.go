@@ -59,3 +59,45 @@ func TestEnvironment(t *testing.T) {
 	assert.Equal("schema_1", Config.SchemaNameTopics["schema_1"])
 	assert.Equal("schema_2", Config.SchemaNameTopics["schema_2"])
 }
+
+func TestNetworkEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	// Set env
+	envMap := map[string]string{
+		"NETWORK_NAME":            "lisbon",
+		"NETWORKS":                "mainnet,lisbon",
+		"KAFKA_BROKER_URL":        "kafka_broker_url",
+		"SCHEMA_REGISTRY_URL":     "schema_registry_url",
+		"LISBON_KAFKA_BROKER_URL": "lisbon_kafka_broker_url",
+		"LISBON_DB_SCHEMA":        "lisbon",
+		"BERLIN_DB_SCHEMA":        "berlin",
+	}
+
+	for k, v := range envMap {
+		os.Setenv(k, v)
+	}
+	defer func() {
+		for k := range envMap {
+			os.Unsetenv(k)
+		}
+	}()
+
+	// Load env
+	ReadEnvironment()
+
+	// Check env
+	assert.Equal("lisbon", Config.NetworkName)
+	assert.Equal([]string{"mainnet", "lisbon"}, Config.Networks)
+	assert.Equal(envMap["LISBON_KAFKA_BROKER_URL"], Config.KafkaBrokerURL)
+	assert.Equal(envMap["SCHEMA_REGISTRY_URL"], Config.SchemaRegistryURL)
+	assert.Equal("lisbon", Config.DbSchema)
+	assert.Equal("icon_transactions_", Config.RedisKeyPrefix)
+}
+
+func TestNetworkEnvPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("LISBON", NetworkEnvPrefix("lisbon"))
+	assert.Equal("SEJONG_TESTNET", NetworkEnvPrefix("sejong-testnet"))
+}
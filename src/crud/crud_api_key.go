@@ -0,0 +1,112 @@
+package crud
+
+import (
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// ApiKeyModel - type for api_key table model
+type ApiKeyModel struct {
+	db           *gorm.DB
+	model        *models.ApiKey
+	modelORM     *models.ApiKeyORM
+	modelTierORM *models.ApiTierORM
+}
+
+var apiKeyModel *ApiKeyModel
+var apiKeyModelOnce sync.Once
+
+// GetApiKeyModel - create and/or return the api_keys table model
+func GetApiKeyModel() *ApiKeyModel {
+	apiKeyModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		apiKeyModel = &ApiKeyModel{
+			db:    dbConn,
+			model: &models.ApiKey{},
+		}
+	})
+
+	return apiKeyModel
+}
+
+// SelectOne - select from api_keys table
+func (m *ApiKeyModel) SelectOne(keyHash string) (*models.ApiKey, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.ApiKey{})
+
+	// Key Hash
+	db = db.Where("key_hash = ?", keyHash)
+
+	apiKey := &models.ApiKey{}
+	db = db.First(apiKey)
+
+	return apiKey, db.Error
+}
+
+// SelectOneTier - select from api_tiers table
+func (m *ApiKeyModel) SelectOneTier(name string) (*models.ApiTier, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.ApiTier{})
+
+	// Name
+	db = db.Where("name = ?", name)
+
+	apiTier := &models.ApiTier{}
+	db = db.First(apiTier)
+
+	return apiTier, db.Error
+}
+
+func (m *ApiKeyModel) UpsertOne(
+	apiKey *models.ApiKey,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*apiKey),
+		reflect.TypeOf(*apiKey),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key_hash"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(apiKey)
+
+	return db.Error
+}
+
+func (m *ApiKeyModel) UpsertOneTier(
+	apiTier *models.ApiTier,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*apiTier),
+		reflect.TypeOf(*apiTier),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(apiTier)
+
+	return db.Error
+}
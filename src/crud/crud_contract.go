@@ -0,0 +1,316 @@
+package crud
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// ContractModel - type for contract table model
+type ContractModel struct {
+	db             *gorm.DB
+	model          *models.Contract
+	modelORM       *models.ContractORM
+	modelUpdateORM *models.ContractUpdateORM
+	LoaderChannel  chan *models.ContractUpdate
+}
+
+var contractModel *ContractModel
+var contractModelOnce sync.Once
+
+// GetContractModel - create and/or return the contracts table model
+func GetContractModel() *ContractModel {
+	contractModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		contractModel = &ContractModel{
+			db:            dbConn,
+			model:         &models.Contract{},
+			LoaderChannel: make(chan *models.ContractUpdate, 1),
+		}
+
+		StartContractLoader()
+	})
+
+	return contractModel
+}
+
+// SelectOne - select from contracts table
+func (m *ContractModel) SelectOne(address string) (*models.Contract, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.Contract{})
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	contract := &models.Contract{}
+	db = db.First(contract)
+
+	return contract, db.Error
+}
+
+// SelectMany - select from contracts table
+// Returns: models, error (if present)
+func (m *ContractModel) SelectMany(
+	limit int,
+	skip int,
+	deployerAddress string,
+	status string,
+) (*[]models.Contract, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Contract{})
+
+	// Latest contracts first
+	db = db.Order("created_block_number desc")
+
+	// Deployer Address
+	if deployerAddress != "" {
+		db = db.Where("deployer_address = ?", deployerAddress)
+	}
+
+	// Status
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	contracts := &[]models.Contract{}
+	db = db.Find(contracts)
+
+	return contracts, db.Error
+}
+
+// CountMany - count from contracts table
+func (m *ContractModel) CountMany(
+	deployerAddress string,
+	status string,
+) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.Contract{})
+
+	// Deployer Address
+	if deployerAddress != "" {
+		db = db.Where("deployer_address = ?", deployerAddress)
+	}
+
+	// Status
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// SelectOneUpdate - select from contract_updates table
+func (m *ContractModel) SelectOneUpdate(transactionHash string) (*models.ContractUpdate, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.ContractUpdate{})
+
+	// Transaction Hash
+	db = db.Where("transaction_hash = ?", transactionHash)
+
+	contractUpdate := &models.ContractUpdate{}
+	db = db.First(contractUpdate)
+
+	return contractUpdate, db.Error
+}
+
+// SelectManyUpdates - deploy history of a contract, oldest first
+// Returns: models, error (if present)
+func (m *ContractModel) SelectManyUpdates(contractAddress string) (*[]models.ContractUpdate, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.ContractUpdate{})
+
+	db = db.Order("block_number")
+
+	// Contract Address
+	db = db.Where("contract_address = ?", contractAddress)
+
+	contractUpdates := &[]models.ContractUpdate{}
+	db = db.Find(contractUpdates)
+
+	return contractUpdates, db.Error
+}
+
+func (m *ContractModel) UpsertOneUpdate(
+	contractUpdate *models.ContractUpdate,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*contractUpdate),
+		reflect.TypeOf(*contractUpdate),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "transaction_hash"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(contractUpdate)
+
+	return db.Error
+}
+
+func (m *ContractModel) UpsertOne(
+	contract *models.Contract,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*contract),
+		reflect.TypeOf(*contract),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(contract)
+
+	return db.Error
+}
+
+// UpdateLatestDeploy - set the updated fields of a contract to its latest deploy transaction
+// NOTE deploys can be loaded out of order, the latest is read from contract_updates
+func (m *ContractModel) UpdateLatestDeploy(contractAddress string) error {
+
+	// Latest deploy
+	latestContractUpdate := &models.ContractUpdate{}
+	db := m.db.Model(&models.ContractUpdate{})
+	db = db.Where("contract_address = ?", contractAddress)
+	db = db.Order("block_number desc")
+	db = db.First(latestContractUpdate)
+	if db.Error != nil {
+		return db.Error
+	}
+
+	db = m.db.Model(&models.Contract{})
+	db = db.Where("address = ?", contractAddress)
+	db = db.Updates(map[string]interface{}{
+		"updated_transaction_hash": latestContractUpdate.TransactionHash,
+		"updated_block_number":     latestContractUpdate.BlockNumber,
+		"updated_timestamp":        latestContractUpdate.BlockTimestamp,
+	})
+
+	return db.Error
+}
+
+// contractStatus - audit status of a deploy transaction
+func contractStatus(acceptTransactionHash string, rejectTransactionHash string) string {
+	if acceptTransactionHash != "" {
+		return "accepted"
+	} else if rejectTransactionHash != "" {
+		return "rejected"
+	}
+
+	return "pending"
+}
+
+// StartContractLoader starts loader
+func StartContractLoader() {
+	go func() {
+		postgresLoaderChan := GetContractModel().LoaderChannel
+
+		for {
+			// Read contract update
+			newContractUpdate := <-postgresLoaderChan
+
+			/////////////////
+			// Enrichments //
+			/////////////////
+
+			// Set Accept/Reject transactions
+			// NOTE accept/reject transactions may be loaded before the deploy transaction
+			transactionCreateScore, err := GetTransactionCreateScoreModel().SelectOne(newContractUpdate.TransactionHash)
+			if err == nil {
+				newContractUpdate.AcceptTransactionHash = transactionCreateScore.AcceptTransactionHash
+				newContractUpdate.RejectTransactionHash = transactionCreateScore.RejectTransactionHash
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				// Postgres error
+				zap.S().Fatal("Loader=Contract, TransactionHash=", newContractUpdate.TransactionHash, " - Error: ", err.Error())
+			}
+			newContractUpdate.Status = contractStatus(newContractUpdate.AcceptTransactionHash, newContractUpdate.RejectTransactionHash)
+
+			//////////////////////
+			// Load to postgres //
+			//////////////////////
+			err = GetContractModel().UpsertOneUpdate(newContractUpdate)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=Contract, TransactionHash=", newContractUpdate.TransactionHash, " - Error: ", err.Error())
+			}
+
+			if newContractUpdate.IsCreation {
+				err = GetContractModel().UpsertOne(&models.Contract{
+					Address:                 newContractUpdate.ContractAddress,
+					DeployerAddress:         newContractUpdate.DeployerAddress,
+					CreationTransactionHash: newContractUpdate.TransactionHash,
+					AcceptTransactionHash:   newContractUpdate.AcceptTransactionHash,
+					RejectTransactionHash:   newContractUpdate.RejectTransactionHash,
+					Status:                  newContractUpdate.Status,
+					CreatedBlockNumber:      newContractUpdate.BlockNumber,
+					CreatedTimestamp:        newContractUpdate.BlockTimestamp,
+				})
+				if err != nil {
+					// Postgres error
+					zap.S().Fatal("Loader=Contract, TransactionHash=", newContractUpdate.TransactionHash, " - Error: ", err.Error())
+				}
+			}
+
+			err = GetContractModel().UpdateLatestDeploy(newContractUpdate.ContractAddress)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=Contract, TransactionHash=", newContractUpdate.TransactionHash, " - Error: ", err.Error())
+			}
+
+			zap.S().Debug("Loader=Contract, TransactionHash=", newContractUpdate.TransactionHash, " ContractAddress=", newContractUpdate.ContractAddress, " - Upserted")
+		}
+	}()
+}
+
+// reloadContractUpdate - Send contract update back to loader for updates
+// NOTE deploy transactions not loaded yet are skipped, the loader reads accept/reject transactions when they arrive
+func reloadContractUpdate(transactionHash string) error {
+
+	curContractUpdate, err := GetContractModel().SelectOneUpdate(transactionHash)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	} else if err != nil {
+		// Postgres error
+		return err
+	}
+	GetContractModel().LoaderChannel <- curContractUpdate
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package crud
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// FeeStatModel - type for addressFeeStat and contractFeeStat table models
+type FeeStatModel struct {
+	db               *gorm.DB
+	model            *models.AddressFeeStat
+	modelORM         *models.AddressFeeStatORM
+	modelContractORM *models.ContractFeeStatORM
+}
+
+var feeStatModel *FeeStatModel
+var feeStatModelOnce sync.Once
+
+// GetFeeStatModel - create and/or return the addressFeeStats and contractFeeStats table model
+func GetFeeStatModel() *FeeStatModel {
+	feeStatModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		feeStatModel = &FeeStatModel{
+			db:    dbConn,
+			model: &models.AddressFeeStat{},
+		}
+	})
+
+	return feeStatModel
+}
+
+// SelectManyByAddress - select from address_fee_stats table
+// Returns: models, error (if present)
+func (m *FeeStatModel) SelectManyByAddress(
+	address string,
+	limit int,
+	skip int,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.AddressFeeStat, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.AddressFeeStat{})
+
+	// Latest buckets first
+	db = db.Order("bucket_timestamp desc")
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	addressFeeStats := &[]models.AddressFeeStat{}
+	db = db.Find(addressFeeStats)
+
+	return addressFeeStats, db.Error
+}
+
+// CountManyByAddress - count from address_fee_stats table
+func (m *FeeStatModel) CountManyByAddress(
+	address string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.AddressFeeStat{})
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// SelectManyByContract - select from contract_fee_stats table
+// Returns: models, error (if present)
+func (m *FeeStatModel) SelectManyByContract(
+	contractAddress string,
+	limit int,
+	skip int,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.ContractFeeStat, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.ContractFeeStat{})
+
+	// Latest buckets first
+	db = db.Order("bucket_timestamp desc")
+
+	// Contract Address
+	db = db.Where("contract_address = ?", contractAddress)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	contractFeeStats := &[]models.ContractFeeStat{}
+	db = db.Find(contractFeeStats)
+
+	return contractFeeStats, db.Error
+}
+
+// CountManyByContract - count from contract_fee_stats table
+func (m *FeeStatModel) CountManyByContract(
+	contractAddress string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.ContractFeeStat{})
+
+	// Contract Address
+	db = db.Where("contract_address = ?", contractAddress)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// applyTransaction - add the fee of a transaction to the sender and called contract daily buckets
+// NOTE called by the transaction stats model, inside the transaction that skips transactions already added
+func (m *FeeStatModel) applyTransaction(
+	tx *gorm.DB,
+	transaction *models.Transaction,
+	fee float64,
+) error {
+
+	bucketSize := transactionStatBucketSizes["daily"]
+	bucketTimestamp := transaction.BlockTimestamp - (transaction.BlockTimestamp % bucketSize)
+
+	// Sender
+	if transaction.FromAddress != "" && transaction.FromAddress != "None" {
+		db := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "address"}, {Name: "bucket_timestamp"}}, // NOTE set to primary keys for table
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"transaction_count": gorm.Expr("address_fee_stats.transaction_count + EXCLUDED.transaction_count"),
+				"fee_total":         gorm.Expr("address_fee_stats.fee_total + EXCLUDED.fee_total"),
+				"step_used_total":   gorm.Expr("address_fee_stats.step_used_total + EXCLUDED.step_used_total"),
+			}),
+		}).Create(&models.AddressFeeStat{
+			Address:          transaction.FromAddress,
+			BucketTimestamp:  bucketTimestamp,
+			TransactionCount: 1,
+			FeeTotal:         fee,
+			StepUsedTotal:    transaction.ReceiptStepUsed,
+		})
+		if db.Error != nil {
+			return db.Error
+		}
+	}
+
+	// Called contract
+	if strings.HasPrefix(transaction.ToAddress, "cx") {
+		db := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "contract_address"}, {Name: "bucket_timestamp"}}, // NOTE set to primary keys for table
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"transaction_count": gorm.Expr("contract_fee_stats.transaction_count + EXCLUDED.transaction_count"),
+				"fee_total":         gorm.Expr("contract_fee_stats.fee_total + EXCLUDED.fee_total"),
+				"step_used_total":   gorm.Expr("contract_fee_stats.step_used_total + EXCLUDED.step_used_total"),
+			}),
+		}).Create(&models.ContractFeeStat{
+			ContractAddress:  transaction.ToAddress,
+			BucketTimestamp:  bucketTimestamp,
+			TransactionCount: 1,
+			FeeTotal:         fee,
+			StepUsedTotal:    transaction.ReceiptStepUsed,
+		})
+		if db.Error != nil {
+			return db.Error
+		}
+	}
+
+	return nil
+}
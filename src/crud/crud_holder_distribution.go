@@ -0,0 +1,211 @@
+package crud
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// IcxDistributionAddress - token contract address of the ICX holder distribution
+const IcxDistributionAddress = "icx"
+
+// HolderDistributionModel - type for holderDistribution table model
+type HolderDistributionModel struct {
+	db       *gorm.DB
+	model    *models.HolderDistribution
+	modelORM *models.HolderDistributionORM
+}
+
+var holderDistributionModel *HolderDistributionModel
+var holderDistributionModelOnce sync.Once
+
+// GetHolderDistributionModel - create and/or return the holderDistributions table model
+func GetHolderDistributionModel() *HolderDistributionModel {
+	holderDistributionModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		holderDistributionModel = &HolderDistributionModel{
+			db:    dbConn,
+			model: &models.HolderDistribution{},
+		}
+	})
+
+	return holderDistributionModel
+}
+
+// SelectOne - select from holder_distributions table
+func (m *HolderDistributionModel) SelectOne(tokenContractAddress string) (*models.HolderDistribution, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.HolderDistribution{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	holderDistribution := &models.HolderDistribution{}
+	db = db.First(holderDistribution)
+
+	return holderDistribution, db.Error
+}
+
+func (m *HolderDistributionModel) UpsertOne(
+	holderDistribution *models.HolderDistribution,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*holderDistribution),
+		reflect.TypeOf(*holderDistribution),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token_contract_address"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(holderDistribution)
+
+	return db.Error
+}
+
+// BuildTokenDistribution - compute the holder distribution of a token contract from token_holders
+// NOTE totalSupply is the 0x prefixed hex result of the token contract totalSupply method
+func (m *HolderDistributionModel) BuildTokenDistribution(
+	tokenContractAddress string,
+	totalSupply string,
+	decimalBase int,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenHolder{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	return m.buildDistribution(db, tokenContractAddress, totalSupply, decimalBase)
+}
+
+// BuildIcxDistribution - compute the holder distribution of ICX from icx_balances
+// NOTE totalSupply is the 0x prefixed hex result of icx_getTotalSupply
+func (m *HolderDistributionModel) BuildIcxDistribution(
+	totalSupply string,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.IcxBalance{})
+
+	return m.buildDistribution(db, IcxDistributionAddress, totalSupply, 18)
+}
+
+func (m *HolderDistributionModel) buildDistribution(
+	db *gorm.DB,
+	tokenContractAddress string,
+	totalSupply string,
+	decimalBase int,
+) error {
+
+	totalSupplyBig, err := hexToBigInt(totalSupply)
+	if err != nil {
+		return err
+	}
+	totalSupplyDecimal := bigIntToFloat64(totalSupplyBig, decimalBase)
+
+	// Smallest balances first
+	db = db.Select("value_decimal")
+	db = db.Where("value_decimal > 0")
+	db = db.Order("value_decimal")
+
+	// Stream rows to keep memory constant
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	distribution := &holderDistribution{}
+	for rows.Next() {
+		valueDecimal := float64(0)
+		err = rows.Scan(&valueDecimal)
+		if err != nil {
+			return err
+		}
+
+		distribution.add(valueDecimal)
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	return m.UpsertOne(&models.HolderDistribution{
+		TokenContractAddress: tokenContractAddress,
+		TotalSupply:          totalSupply,
+		TotalSupplyDecimal:   totalSupplyDecimal,
+		HolderCount:          distribution.count,
+		Top10Percentage:      distribution.topPercentage(10, totalSupplyDecimal),
+		Top100Percentage:     distribution.topPercentage(100, totalSupplyDecimal),
+		Gini:                 distribution.gini(),
+		UpdatedTimestamp:     uint64(time.Now().UnixNano() / 1000),
+	})
+}
+
+// holderDistribution - running totals of balances added smallest first
+type holderDistribution struct {
+	count       uint64
+	sum         float64
+	weightedSum float64
+
+	// Largest 100 balances, smallest first
+	largest []float64
+}
+
+func (d *holderDistribution) add(value float64) {
+	d.count++
+	d.sum += value
+	d.weightedSum += float64(d.count) * value
+
+	d.largest = append(d.largest, value)
+	if len(d.largest) > 100 {
+		d.largest = d.largest[1:]
+	}
+}
+
+// gini - Gini coefficient of balances sorted ascending
+func (d *holderDistribution) gini() float64 {
+	if d.count == 0 || d.sum == 0 {
+		return 0
+	}
+
+	n := float64(d.count)
+	return (2*d.weightedSum)/(n*d.sum) - (n+1)/n
+}
+
+// topPercentage - percentage of total supply held by the largest n holders
+// NOTE the sum of balances is used if total supply is unknown
+func (d *holderDistribution) topPercentage(n int, totalSupply float64) float64 {
+	if totalSupply <= 0 {
+		totalSupply = d.sum
+	}
+	if totalSupply == 0 {
+		return 0
+	}
+
+	top := float64(0)
+	for i := len(d.largest) - 1; i >= 0 && i >= len(d.largest)-n; i-- {
+		top += d.largest[i]
+	}
+
+	return top / totalSupply * 100
+}
@@ -0,0 +1,44 @@
+//+build unit
+
+package crud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolderDistribution(t *testing.T) {
+	assert := assert.New(t)
+
+	// Equal balances
+	distribution := &holderDistribution{}
+	for i := 0; i < 4; i++ {
+		distribution.add(10)
+	}
+	assert.Equal(uint64(4), distribution.count)
+	assert.InDelta(0, distribution.gini(), 1e-9)
+	assert.InDelta(100, distribution.topPercentage(10, 0), 1e-9)
+	assert.InDelta(20, distribution.topPercentage(10, 200), 1e-9)
+
+	// One holder owns everything
+	distribution = &holderDistribution{}
+	for _, v := range []float64{0.0001, 0.0001, 0.0001, 1000} {
+		distribution.add(v)
+	}
+	assert.InDelta(0.75, distribution.gini(), 1e-3)
+
+	// Only the largest balances are kept
+	distribution = &holderDistribution{}
+	for i := 1; i <= 200; i++ {
+		distribution.add(float64(i))
+	}
+	assert.Equal(100, len(distribution.largest))
+	assert.InDelta(float64(191+200)*10/2/distribution.sum*100, distribution.topPercentage(10, 0), 1e-9)
+	assert.InDelta(float64(101+200)*100/2/distribution.sum*100, distribution.topPercentage(100, 0), 1e-9)
+
+	// Empty
+	distribution = &holderDistribution{}
+	assert.Equal(0.0, distribution.gini())
+	assert.Equal(0.0, distribution.topPercentage(10, 0))
+}
@@ -0,0 +1,87 @@
+package crud
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// IcxBalanceModel - type for icxBalance table model
+type IcxBalanceModel struct {
+	db       *gorm.DB
+	model    *models.IcxBalance
+	modelORM *models.IcxBalanceORM
+}
+
+var icxBalanceModel *IcxBalanceModel
+var icxBalanceModelOnce sync.Once
+
+// GetIcxBalanceModel - create and/or return the icxBalances table model
+func GetIcxBalanceModel() *IcxBalanceModel {
+	icxBalanceModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		icxBalanceModel = &IcxBalanceModel{
+			db:    dbConn,
+			model: &models.IcxBalance{},
+		}
+	})
+
+	return icxBalanceModel
+}
+
+// SelectMany - select from icx_balances table, largest balances first
+// NOTE empty balances are skipped
+// Returns: models, error (if present)
+func (m *IcxBalanceModel) SelectMany(
+	limit int,
+	skip int,
+) (*[]models.IcxBalance, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.IcxBalance{})
+
+	db = db.Order("value_decimal desc")
+
+	// Empty balances
+	db = db.Where("value_decimal > 0")
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	icxBalances := &[]models.IcxBalance{}
+	db = db.Find(icxBalances)
+
+	return icxBalances, db.Error
+}
+
+func (m *IcxBalanceModel) UpsertOne(
+	icxBalance *models.IcxBalance,
+) error {
+	db := m.db
+
+	// NOTE value_decimal is always set so empty balances are not skipped by the upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "address"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"value":             icxBalance.Value,
+			"value_decimal":     icxBalance.ValueDecimal,
+			"updated_timestamp": icxBalance.UpdatedTimestamp,
+		}),
+	}).Create(icxBalance)
+
+	return db.Error
+}
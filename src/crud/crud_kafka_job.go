@@ -34,23 +34,11 @@ func GetKafkaJobModel() *KafkaJobModel {
 			modelORM:      &models.KafkaJobORM{},
 			LoaderChannel: make(chan *models.KafkaJob, 1),
 		}
-
-		err := kafkaJobModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("KafkaJobModel: Unable migrate postgres table: ", err.Error())
-		}
 	})
 
 	return kafkaJobModel
 }
 
-// Migrate - migrate kafkaJobs table
-func (m *KafkaJobModel) Migrate() error {
-	// Only using KafkaJobRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // SelectMany - select from kafkaJobs table
 func (m *KafkaJobModel) SelectMany(
 	jobID string,
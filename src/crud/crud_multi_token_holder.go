@@ -0,0 +1,314 @@
+package crud
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// MultiTokenHolderModel - type for multiTokenHolder table model
+type MultiTokenHolderModel struct {
+	db            *gorm.DB
+	model         *models.MultiTokenHolder
+	modelORM      *models.MultiTokenHolderORM
+	LoaderChannel chan *models.MultiTokenHolder
+}
+
+var multiTokenHolderModel *MultiTokenHolderModel
+var multiTokenHolderModelOnce sync.Once
+
+// GetMultiTokenHolderModel - create and/or return the multiTokenHolders table model
+func GetMultiTokenHolderModel() *MultiTokenHolderModel {
+	multiTokenHolderModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		multiTokenHolderModel = &MultiTokenHolderModel{
+			db:            dbConn,
+			model:         &models.MultiTokenHolder{},
+			LoaderChannel: make(chan *models.MultiTokenHolder, 1),
+		}
+
+		StartMultiTokenHolderLoader()
+	})
+
+	return multiTokenHolderModel
+}
+
+// SelectOne - select from multi_token_holders table
+// Returns: models, error (if present)
+func (m *MultiTokenHolderModel) SelectOne(
+	tokenContractAddress string,
+	tokenID string,
+	holderAddress string,
+) (*models.MultiTokenHolder, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenHolder{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	db = db.Where("token_id = ?", tokenID)
+
+	// Holder Address
+	db = db.Where("holder_address = ?", holderAddress)
+
+	multiTokenHolder := &models.MultiTokenHolder{}
+	db = db.First(multiTokenHolder)
+
+	return multiTokenHolder, db.Error
+}
+
+// SelectManyByTokenContractAddress - select from multi_token_holders table
+// Returns: models, error (if present)
+func (m *MultiTokenHolderModel) SelectManyByTokenContractAddress(
+	limit int,
+	skip int,
+	tokenContractAddress string,
+	tokenID string,
+) (*[]models.MultiTokenHolder, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenHolder{})
+
+	db = db.Order("token_id, holder_address")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Empty balances
+	db = db.Where("value != ?", "0x0")
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	multiTokenHolders := &[]models.MultiTokenHolder{}
+	db = db.Find(multiTokenHolders)
+
+	return multiTokenHolders, db.Error
+}
+
+// StreamManyByTokenContractAddress - stream from multi_token_holders table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *MultiTokenHolderModel) StreamManyByTokenContractAddress(
+	tokenContractAddress string,
+	tokenID string,
+	options *StreamOptions,
+	fn func(*models.MultiTokenHolder) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenHolder{})
+
+	db = db.Order("token_id, holder_address")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Empty balances
+	db = db.Where("value != ?", "0x0")
+
+	// Columns and ranges
+	// NOTE block_number is the block of the last balance change
+	db = applyStreamOptions(db, options, "block_number", "")
+
+	multiTokenHolder := &models.MultiTokenHolder{}
+	return streamRows(db, multiTokenHolder, func() error {
+		return fn(multiTokenHolder)
+	})
+}
+
+// CountByTokenContract - Count holders by token contract
+func (m *MultiTokenHolderModel) CountByTokenContract(tokenContractAddress string, tokenID string) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.MultiTokenHolder{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Empty balances
+	db = db.Where("value != ?", "0x0")
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+func (m *MultiTokenHolderModel) UpsertOne(
+	multiTokenHolder *models.MultiTokenHolder,
+) error {
+	db := m.db
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*multiTokenHolder),
+		reflect.TypeOf(*multiTokenHolder),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token_contract_address"}, {Name: "token_id"}, {Name: "holder_address"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(multiTokenHolder)
+
+	return db.Error
+}
+
+// applyTransfer - subtract a transfer from the sender balance and add it to the receiver balance
+// NOTE must only be called once per transfer, in the transaction inserting it
+func (m *MultiTokenHolderModel) applyTransfer(
+	db *gorm.DB,
+	multiTokenTransfer *models.MultiTokenTransfer,
+) error {
+
+	value, err := hexToBigInt(multiTokenTransfer.Value)
+	if err != nil {
+		return err
+	}
+
+	// From address
+	if multiTokenTransfer.FromAddress != tokenZeroAddress {
+		err = m.applyDelta(
+			db,
+			multiTokenTransfer.TokenContractAddress,
+			multiTokenTransfer.TokenId,
+			multiTokenTransfer.FromAddress,
+			new(big.Int).Neg(value),
+			multiTokenTransfer.BlockNumber,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// To address
+	if multiTokenTransfer.ToAddress != tokenZeroAddress {
+		err = m.applyDelta(
+			db,
+			multiTokenTransfer.TokenContractAddress,
+			multiTokenTransfer.TokenId,
+			multiTokenTransfer.ToAddress,
+			value,
+			multiTokenTransfer.BlockNumber,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyDelta - add delta to a holder balance
+// NOTE values are hex strings, the row is locked while the new balance is computed
+func (m *MultiTokenHolderModel) applyDelta(
+	db *gorm.DB,
+	tokenContractAddress string,
+	tokenID string,
+	holderAddress string,
+	delta *big.Int,
+	blockNumber uint64,
+) error {
+
+	// Create empty balance
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.MultiTokenHolder{
+		TokenContractAddress: tokenContractAddress,
+		TokenId:              tokenID,
+		HolderAddress:        holderAddress,
+		Value:                bigIntToHex(big.NewInt(0)),
+		BlockNumber:          blockNumber,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	// Lock balance
+	curMultiTokenHolder := &models.MultiTokenHolder{}
+	err = db.Model(&models.MultiTokenHolder{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_contract_address = ?", tokenContractAddress).
+		Where("token_id = ?", tokenID).
+		Where("holder_address = ?", holderAddress).
+		First(curMultiTokenHolder).Error
+	if err != nil {
+		return err
+	}
+
+	balance, err := hexToBigInt(curMultiTokenHolder.Value)
+	if err != nil {
+		return err
+	}
+	balance = balance.Add(balance, delta)
+
+	// NOTE block number is the last block changing the balance, transfers may load out of order
+	if curMultiTokenHolder.BlockNumber > blockNumber {
+		blockNumber = curMultiTokenHolder.BlockNumber
+	}
+
+	return db.Model(&models.MultiTokenHolder{}).
+		Where("token_contract_address = ?", tokenContractAddress).
+		Where("token_id = ?", tokenID).
+		Where("holder_address = ?", holderAddress).
+		Updates(map[string]interface{}{
+			"value":        bigIntToHex(balance),
+			"block_number": blockNumber,
+		}).Error
+}
+
+// StartMultiTokenHolderLoader starts loader
+func StartMultiTokenHolderLoader() {
+	go func() {
+		postgresLoaderChan := GetMultiTokenHolderModel().LoaderChannel
+
+		for {
+			// Read multiTokenHolder
+			newMultiTokenHolder := <-postgresLoaderChan
+
+			//////////////////////
+			// Load to postgres //
+			//////////////////////
+			err := GetMultiTokenHolderModel().UpsertOne(newMultiTokenHolder)
+			zap.S().Debug("Loader=MultiTokenHolder, TokenContractAddress=", newMultiTokenHolder.TokenContractAddress, " TokenID=", newMultiTokenHolder.TokenId, " HolderAddress=", newMultiTokenHolder.HolderAddress, " - Upserted")
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=MultiTokenHolder, TokenContractAddress=", newMultiTokenHolder.TokenContractAddress, " TokenID=", newMultiTokenHolder.TokenId, " HolderAddress=", newMultiTokenHolder.HolderAddress, " - Error: ", err.Error())
+			}
+		}
+	}()
+}
@@ -0,0 +1,480 @@
+package crud
+
+import (
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// MultiTokenTransferModel - type for multiTokenTransfer table model
+type MultiTokenTransferModel struct {
+	db            *gorm.DB
+	model         *models.MultiTokenTransfer
+	modelORM      *models.MultiTokenTransferORM
+	LoaderChannel chan *models.MultiTokenTransfer
+}
+
+var multiTokenTransferModel *MultiTokenTransferModel
+var multiTokenTransferModelOnce sync.Once
+
+// GetMultiTokenTransferModel - create and/or return the multiTokenTransfers table model
+func GetMultiTokenTransferModel() *MultiTokenTransferModel {
+	multiTokenTransferModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		multiTokenTransferModel = &MultiTokenTransferModel{
+			db:            dbConn,
+			model:         &models.MultiTokenTransfer{},
+			LoaderChannel: make(chan *models.MultiTokenTransfer, 1),
+		}
+
+		StartMultiTokenTransferLoader()
+	})
+
+	return multiTokenTransferModel
+}
+
+// SelectOne - select from multi_token_transfers table
+// Returns: models, error (if present)
+func (m *MultiTokenTransferModel) SelectOne(
+	transactionHash string,
+	logIndex int32,
+	batchIndex int32,
+) (*models.MultiTokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Transaction Hash
+	db = db.Where("transaction_hash = ?", transactionHash)
+
+	// Log Index
+	db = db.Where("log_index = ?", logIndex)
+
+	// Batch Index
+	db = db.Where("batch_index = ?", batchIndex)
+
+	multiTokenTransfer := &models.MultiTokenTransfer{}
+	db = db.First(multiTokenTransfer)
+
+	return multiTokenTransfer, db.Error
+}
+
+// SelectMany - select from multi_token_transfers table
+// Returns: models, error (if present)
+func (m *MultiTokenTransferModel) SelectMany(
+	limit int,
+	skip int,
+	from string,
+	to string,
+	blockNumber int,
+	startBlockNumber int,
+	endBlockNumber int,
+	startTimestamp int64,
+	endTimestamp int64,
+	transactionHash string,
+	tokenContractAddress string,
+	tokenID string,
+) (*[]models.MultiTokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// from
+	if from != "" {
+		db = db.Where("from_address = ?", from)
+	}
+
+	// to
+	if to != "" {
+		db = db.Where("to_address = ?", to)
+	}
+
+	// block number
+	if blockNumber != 0 {
+		db = db.Where("block_number = ?", blockNumber)
+	}
+
+	// start block number
+	if startBlockNumber != 0 {
+		db = db.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		db = db.Where("block_number <= ?", endBlockNumber)
+	}
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
+	// transaction hash
+	if transactionHash != "" {
+		db = db.Where("transaction_hash = ?", transactionHash)
+	}
+
+	// token contract address
+	if tokenContractAddress != "" {
+		db = db.Where("token_contract_address = ?", tokenContractAddress)
+	}
+
+	// token id
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	multiTokenTransfers := &[]models.MultiTokenTransfer{}
+	db = db.Find(multiTokenTransfers)
+
+	return multiTokenTransfers, db.Error
+}
+
+// SelectManyByAddress - select from multi_token_transfers table by from or to address
+// Returns: models, error (if present)
+func (m *MultiTokenTransferModel) SelectManyByAddress(
+	limit int,
+	skip int,
+	address string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.MultiTokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// Address
+	db = db.Where("from_address = ? OR to_address = ?", address, address)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	multiTokenTransfers := &[]models.MultiTokenTransfer{}
+	db = db.Find(multiTokenTransfers)
+
+	return multiTokenTransfers, db.Error
+}
+
+// SelectManyByTokenContractAddress - select from multi_token_transfers table by token contract address
+// Returns: models, error (if present)
+func (m *MultiTokenTransferModel) SelectManyByTokenContractAddress(
+	limit int,
+	skip int,
+	tokenContractAddress string,
+	tokenID string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.MultiTokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	multiTokenTransfers := &[]models.MultiTokenTransfer{}
+	db = db.Find(multiTokenTransfers)
+
+	return multiTokenTransfers, db.Error
+}
+
+// StreamMany - stream from multi_token_transfers table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *MultiTokenTransferModel) StreamMany(
+	from string,
+	to string,
+	blockNumber int,
+	transactionHash string,
+	tokenContractAddress string,
+	tokenID string,
+	options *StreamOptions,
+	fn func(*models.MultiTokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// from
+	if from != "" {
+		db = db.Where("from_address = ?", from)
+	}
+
+	// to
+	if to != "" {
+		db = db.Where("to_address = ?", to)
+	}
+
+	// block number
+	if blockNumber != 0 {
+		db = db.Where("block_number = ?", blockNumber)
+	}
+
+	// transaction hash
+	if transactionHash != "" {
+		db = db.Where("transaction_hash = ?", transactionHash)
+	}
+
+	// token contract address
+	if tokenContractAddress != "" {
+		db = db.Where("token_contract_address = ?", tokenContractAddress)
+	}
+
+	// token id
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	multiTokenTransfer := &models.MultiTokenTransfer{}
+	return streamRows(db, multiTokenTransfer, func() error {
+		return fn(multiTokenTransfer)
+	})
+}
+
+// StreamManyByAddress - stream from multi_token_transfers table by from or to address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *MultiTokenTransferModel) StreamManyByAddress(
+	address string,
+	options *StreamOptions,
+	fn func(*models.MultiTokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// Address
+	db = db.Where("from_address = ? OR to_address = ?", address, address)
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	multiTokenTransfer := &models.MultiTokenTransfer{}
+	return streamRows(db, multiTokenTransfer, func() error {
+		return fn(multiTokenTransfer)
+	})
+}
+
+// StreamManyByTokenContractAddress - stream from multi_token_transfers table by token contract address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *MultiTokenTransferModel) StreamManyByTokenContractAddress(
+	tokenContractAddress string,
+	tokenID string,
+	options *StreamOptions,
+	fn func(*models.MultiTokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.MultiTokenTransfer{})
+
+	// Latest transfers first
+	db = db.Order("block_number desc, log_index, batch_index")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	multiTokenTransfer := &models.MultiTokenTransfer{}
+	return streamRows(db, multiTokenTransfer, func() error {
+		return fn(multiTokenTransfer)
+	})
+}
+
+// CountByAddress - Count by from or to address
+func (m *MultiTokenTransferModel) CountByAddress(address string) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.MultiTokenTransfer{})
+
+	// Address
+	db = db.Where("from_address = ? OR to_address = ?", address, address)
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// CountByTokenContract - Count by token contract
+func (m *MultiTokenTransferModel) CountByTokenContract(tokenContractAddress string, tokenID string) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.MultiTokenTransfer{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Token ID
+	if tokenID != "" {
+		db = db.Where("token_id = ?", tokenID)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+func (m *MultiTokenTransferModel) UpsertOne(
+	multiTokenTransfer *models.MultiTokenTransfer,
+) error {
+	return m.upsertOne(m.db, multiTokenTransfer)
+}
+
+func (m *MultiTokenTransferModel) upsertOne(
+	db *gorm.DB,
+	multiTokenTransfer *models.MultiTokenTransfer,
+) error {
+
+	// map[string]interface{}
+	updateOnConflictValues := extractFilledFieldsFromModel(
+		reflect.ValueOf(*multiTokenTransfer),
+		reflect.TypeOf(*multiTokenTransfer),
+	)
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}, {Name: "batch_index"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(updateOnConflictValues),
+	}).Create(multiTokenTransfer)
+
+	return db.Error
+}
+
+// LoadOne - insert a transfer and apply it to the holder balances in one transaction
+// NOTE transfers loaded before, ex replayed by kafka, are updated without applying the deltas again
+func (m *MultiTokenTransferModel) LoadOne(
+	multiTokenTransfer *models.MultiTokenTransfer,
+) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+
+		// Insert
+		db := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(multiTokenTransfer)
+		if db.Error != nil {
+			return db.Error
+		}
+
+		if db.RowsAffected == 0 {
+			// Loaded before
+			return m.upsertOne(tx, multiTokenTransfer)
+		}
+
+		// Holder balances
+		return GetMultiTokenHolderModel().applyTransfer(tx, multiTokenTransfer)
+	})
+}
+
+// StartMultiTokenTransferLoader starts loader
+func StartMultiTokenTransferLoader() {
+	go func() {
+		postgresLoaderChan := GetMultiTokenTransferModel().LoaderChannel
+
+		for {
+			// Read multiTokenTransfer
+			newMultiTokenTransfer := <-postgresLoaderChan
+
+			//////////////////////
+			// Load to postgres //
+			//////////////////////
+			// NOTE holder balances are applied with the insert
+			err := GetMultiTokenTransferModel().LoadOne(newMultiTokenTransfer)
+			zap.S().Debug("Loader=MultiTokenTransfer, Hash=", newMultiTokenTransfer.TransactionHash, " LogIndex=", newMultiTokenTransfer.LogIndex, " BatchIndex=", newMultiTokenTransfer.BatchIndex, " - Loaded")
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=MultiTokenTransfer, Hash=", newMultiTokenTransfer.TransactionHash, " LogIndex=", newMultiTokenTransfer.LogIndex, " BatchIndex=", newMultiTokenTransfer.BatchIndex, " - Error: ", err.Error())
+			}
+		}
+	}()
+}
@@ -0,0 +1,81 @@
+package crud
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// RoutineCheckpointModel - type for routine_checkpoints table model
+type RoutineCheckpointModel struct {
+	db       *gorm.DB
+	model    *models.RoutineCheckpoint
+	modelORM *models.RoutineCheckpointORM
+}
+
+var routineCheckpointModel *RoutineCheckpointModel
+var routineCheckpointModelOnce sync.Once
+
+// GetRoutineCheckpointModel - create and/or return the routine_checkpoints table model
+// NOTE routine_checkpoints is created by migration 000004
+func GetRoutineCheckpointModel() *RoutineCheckpointModel {
+	routineCheckpointModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		routineCheckpointModel = &RoutineCheckpointModel{
+			db:    dbConn,
+			model: &models.RoutineCheckpoint{},
+		}
+	})
+
+	return routineCheckpointModel
+}
+
+// SelectCursor - cursor of a routine
+// Returns: cursor, empty if the routine has no checkpoint, error (if present)
+func (m *RoutineCheckpointModel) SelectCursor(name string) (string, error) {
+	db := usePrimary(m.db)
+
+	// Set table
+	db = db.Model(&models.RoutineCheckpoint{})
+
+	// Name
+	db = db.Where("name = ?", name)
+
+	routineCheckpoint := &models.RoutineCheckpoint{}
+	db = db.First(routineCheckpoint)
+	if errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+
+	return routineCheckpoint.Cursor, db.Error
+}
+
+// UpsertCursor - set the cursor of a routine
+// NOTE an empty cursor is written, the next run starts over
+func (m *RoutineCheckpointModel) UpsertCursor(name string, cursor string) error {
+	db := m.db
+
+	routineCheckpoint := &models.RoutineCheckpoint{
+		Name:             name,
+		Cursor:           cursor,
+		UpdatedTimestamp: uint64(time.Now().UnixNano() / 1000),
+	}
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.AssignmentColumns([]string{"cursor", "updated_timestamp"}),
+	}).Create(routineCheckpoint)
+
+	return db.Error
+}
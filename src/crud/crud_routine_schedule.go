@@ -0,0 +1,104 @@
+package crud
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// RoutineScheduleModel - type for routine_schedules table model
+type RoutineScheduleModel struct {
+	db       *gorm.DB
+	model    *models.RoutineSchedule
+	modelORM *models.RoutineScheduleORM
+}
+
+var routineScheduleModel *RoutineScheduleModel
+var routineScheduleModelOnce sync.Once
+
+// GetRoutineScheduleModel - create and/or return the routine_schedules table model
+// NOTE routine_schedules is created by migration 000003
+func GetRoutineScheduleModel() *RoutineScheduleModel {
+	routineScheduleModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		routineScheduleModel = &RoutineScheduleModel{
+			db:    dbConn,
+			model: &models.RoutineSchedule{},
+		}
+	})
+
+	return routineScheduleModel
+}
+
+// SelectOne - select from routine_schedules table
+func (m *RoutineScheduleModel) SelectOne(name string) (*models.RoutineSchedule, error) {
+	db := usePrimary(m.db)
+
+	// Set table
+	db = db.Model(&models.RoutineSchedule{})
+
+	// Name
+	db = db.Where("name = ?", name)
+
+	routineSchedule := &models.RoutineSchedule{}
+	db = db.First(routineSchedule)
+
+	return routineSchedule, db.Error
+}
+
+// UpsertSchedule - set the schedule and next run of a routine
+// NOTE the last run is kept
+func (m *RoutineScheduleModel) UpsertSchedule(name string, schedule string, nextRunTimestamp uint64) error {
+	db := m.db
+
+	routineSchedule := &models.RoutineSchedule{
+		Name:             name,
+		Schedule:         schedule,
+		NextRunTimestamp: nextRunTimestamp,
+	}
+
+	// Upsert
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.AssignmentColumns([]string{"schedule", "next_run_timestamp"}),
+	}).Create(routineSchedule)
+
+	return db.Error
+}
+
+// UpdateRun - set the status of the last run of a routine
+// NOTE zero values are written, ex an empty error after a failed run
+func (m *RoutineScheduleModel) UpdateRun(routineSchedule *models.RoutineSchedule) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.RoutineSchedule{})
+
+	// Name
+	db = db.Where("name = ?", routineSchedule.Name)
+
+	db = db.Updates(map[string]interface{}{
+		"status":                   routineSchedule.Status,
+		"error":                    routineSchedule.Error,
+		"worker":                   routineSchedule.Worker,
+		"last_run_start_timestamp": routineSchedule.LastRunStartTimestamp,
+		"last_run_end_timestamp":   routineSchedule.LastRunEndTimestamp,
+	})
+
+	return db.Error
+}
+
+// TryLock - take the lock of a routine without waiting
+// Returns: unlock, locked, error (if present)
+// NOTE the lock is held by one worker across replicas until unlock
+func (m *RoutineScheduleModel) TryLock(name string) (func(), bool, error) {
+	return advisoryLock(m.db, "routine:"+name, false)
+}
@@ -0,0 +1,232 @@
+package crud
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// TokenContractMover - transfer totals of an address in a window
+type TokenContractMover struct {
+	Address       string  `json:"address"`
+	SentValue     float64 `json:"sent_value"`
+	ReceivedValue float64 `json:"received_value"`
+	TransferCount int64   `json:"transfer_count"`
+}
+
+// TokenContractStatModel - type for tokenContractStat table model
+type TokenContractStatModel struct {
+	db              *gorm.DB
+	model           *models.TokenContractStat
+	modelORM        *models.TokenContractStatORM
+	modelAddressORM *models.TokenContractStatAddressORM
+}
+
+var tokenContractStatModel *TokenContractStatModel
+var tokenContractStatModelOnce sync.Once
+
+// GetTokenContractStatModel - create and/or return the tokenContractStats table model
+func GetTokenContractStatModel() *TokenContractStatModel {
+	tokenContractStatModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		tokenContractStatModel = &TokenContractStatModel{
+			db:    dbConn,
+			model: &models.TokenContractStat{},
+		}
+	})
+
+	return tokenContractStatModel
+}
+
+// SelectMany - select from token_contract_stats table
+// Returns: models, error (if present)
+func (m *TokenContractStatModel) SelectMany(
+	tokenContractAddress string,
+	limit int,
+	skip int,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.TokenContractStat, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenContractStat{})
+
+	// Latest buckets first
+	db = db.Order("bucket_timestamp desc")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	tokenContractStats := &[]models.TokenContractStat{}
+	db = db.Find(tokenContractStats)
+
+	return tokenContractStats, db.Error
+}
+
+// CountMany - count from token_contract_stats table
+func (m *TokenContractStatModel) CountMany(
+	tokenContractAddress string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenContractStat{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// SelectTopMovers - addresses with the most value moved by a token contract in a window
+// NOTE scans token_transfers, windows should be kept short
+// Returns: movers sorted by sent and received value, error (if present)
+func (m *TokenContractStatModel) SelectTopMovers(
+	tokenContractAddress string,
+	startTimestamp int64,
+	endTimestamp int64,
+	limit int,
+) (*[]TokenContractMover, error) {
+
+	transfersDB := m.db.Model(&models.TokenTransfer{})
+	transfersDB = transfersDB.Where("token_contract_address = ?", tokenContractAddress)
+	transfersDB = transfersDB.Where("block_timestamp >= ? AND block_timestamp <= ?", startTimestamp, endTimestamp)
+
+	sentDB := transfersDB.Session(&gorm.Session{}).Select("from_address AS address, value_decimal AS sent_value, 0 AS received_value")
+	receivedDB := transfersDB.Session(&gorm.Session{}).Select("to_address AS address, 0 AS sent_value, value_decimal AS received_value")
+
+	db := m.db.Table("(? UNION ALL ?) AS movers", sentDB, receivedDB)
+	db = db.Select("address, SUM(sent_value) AS sent_value, SUM(received_value) AS received_value, COUNT(*) AS transfer_count")
+	db = db.Where("address != ?", tokenZeroAddress)
+	db = db.Group("address")
+	db = db.Order("SUM(sent_value) + SUM(received_value) desc")
+	db = db.Limit(limit)
+
+	tokenContractMovers := &[]TokenContractMover{}
+	db = db.Scan(tokenContractMovers)
+
+	return tokenContractMovers, db.Error
+}
+
+// applyTokenTransfer - add a token transfer to its daily bucket
+// NOTE called by the transaction stats model, inside the transaction that skips transfers already added
+func (m *TokenContractStatModel) applyTokenTransfer(
+	tx *gorm.DB,
+	tokenTransfer *models.TokenTransfer,
+) error {
+
+	bucketSize := transactionStatBucketSizes["daily"]
+
+	tokenContractStat := &models.TokenContractStat{
+		TokenContractAddress: tokenTransfer.TokenContractAddress,
+		BucketTimestamp:      tokenTransfer.BlockTimestamp - (tokenTransfer.BlockTimestamp % bucketSize),
+		TransferCount:        1,
+		Volume:               tokenTransfer.ValueDecimal,
+	}
+
+	// Unique senders and receivers
+	for _, isSender := range []bool{true, false} {
+		address := tokenTransfer.ToAddress
+		if isSender {
+			address = tokenTransfer.FromAddress
+		}
+		if address == tokenZeroAddress {
+			// Mint or burn
+			continue
+		}
+
+		db := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.TokenContractStatAddress{
+			TokenContractAddress: tokenContractStat.TokenContractAddress,
+			BucketTimestamp:      tokenContractStat.BucketTimestamp,
+			Address:              address,
+			IsSender:             isSender,
+		})
+		if db.Error != nil {
+			return db.Error
+		}
+
+		if isSender {
+			tokenContractStat.UniqueSenderCount = uint64(db.RowsAffected)
+		} else {
+			tokenContractStat.UniqueReceiverCount = uint64(db.RowsAffected)
+		}
+	}
+
+	// Increment
+	db := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "token_contract_address"}, {Name: "bucket_timestamp"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"transfer_count":        gorm.Expr("token_contract_stats.transfer_count + EXCLUDED.transfer_count"),
+			"volume":                gorm.Expr("token_contract_stats.volume + EXCLUDED.volume"),
+			"unique_sender_count":   gorm.Expr("token_contract_stats.unique_sender_count + EXCLUDED.unique_sender_count"),
+			"unique_receiver_count": gorm.Expr("token_contract_stats.unique_receiver_count + EXCLUDED.unique_receiver_count"),
+		}),
+	}).Create(tokenContractStat)
+
+	return db.Error
+}
+
+// UpdateHolderCount - set the holder count of the bucket of a block timestamp
+// NOTE blockTimestamp is of the latest token transfer counted
+func (m *TokenContractStatModel) UpdateHolderCount(
+	tokenContractAddress string,
+	holderCount uint64,
+	blockTimestamp uint64,
+) error {
+	db := m.db
+
+	bucketSize := transactionStatBucketSizes["daily"]
+
+	db = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token_contract_address"}, {Name: "bucket_timestamp"}}, // NOTE set to primary keys for table
+		DoUpdates: clause.AssignmentColumns([]string{"holder_count"}),
+	}).Create(&models.TokenContractStat{
+		TokenContractAddress: tokenContractAddress,
+		BucketTimestamp:      blockTimestamp - (blockTimestamp % bucketSize),
+		HolderCount:          holderCount,
+	})
+
+	return db.Error
+}
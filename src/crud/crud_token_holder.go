@@ -1,6 +1,7 @@
 package crud
 
 import (
+	"math/big"
 	"reflect"
 	"sync"
 
@@ -8,7 +9,9 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
 )
 
 // TokenHolderModel - type for tokenHolder table model
@@ -36,22 +39,33 @@ func GetTokenHolderModel() *TokenHolderModel {
 			LoaderChannel: make(chan *models.TokenHolder, 1),
 		}
 
-		err := tokenHolderModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TokenHolderModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTokenHolderLoader()
 	})
 
 	return tokenHolderModel
 }
 
-// Migrate - migrate tokenHolders table
-func (m *TokenHolderModel) Migrate() error {
-	// Only using TokenHolderRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
+// SelectOne - select from token_holders table
+// Returns: models, error (if present)
+func (m *TokenHolderModel) SelectOne(
+	tokenContractAddress string,
+	holderAddress string,
+) (*models.TokenHolder, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenHolder{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Holder Address
+	db = db.Where("holder_address = ?", holderAddress)
+
+	tokenHolder := &models.TokenHolder{}
+	db = db.First(tokenHolder)
+
+	return tokenHolder, db.Error
 }
 
 // SelectMany - select from token_transfers table
@@ -81,6 +95,63 @@ func (m *TokenHolderModel) SelectMany(
 	return tokenHolders, db.Error
 }
 
+// SelectManyByPrimaryKey - select from token_holders table in a stable order
+// Used by routines paging through the whole table
+// Returns: models, error (if present)
+func (m *TokenHolderModel) SelectManyByPrimaryKey(
+	limit int,
+	skip int,
+) (*[]models.TokenHolder, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenHolder{})
+
+	db = db.Order("token_contract_address, holder_address")
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	tokenHolders := &[]models.TokenHolder{}
+	db = db.Find(tokenHolders)
+
+	return tokenHolders, db.Error
+}
+
+// SelectManyAfterPrimaryKey - select from token_holders table in primary key order
+// Used by routines resuming from the last token holder processed
+// Returns: models, error (if present)
+func (m *TokenHolderModel) SelectManyAfterPrimaryKey(
+	limit int,
+	tokenContractAddress string,
+	holderAddress string,
+) (*[]models.TokenHolder, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenHolder{})
+
+	// Primary key
+	if tokenContractAddress != "" {
+		db = db.Where("(token_contract_address, holder_address) > (?, ?)", tokenContractAddress, holderAddress)
+	}
+
+	db = db.Order("token_contract_address, holder_address")
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	tokenHolders := &[]models.TokenHolder{}
+	db = db.Find(tokenHolders)
+
+	return tokenHolders, db.Error
+}
+
 // SelectMany - select from token_transfers table
 // Returns: models, error (if present)
 func (m *TokenHolderModel) SelectManyByTokenContractAddress(
@@ -112,6 +183,32 @@ func (m *TokenHolderModel) SelectManyByTokenContractAddress(
 	return tokenHolders, db.Error
 }
 
+// StreamManyByTokenContractAddress - stream from token_holders table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TokenHolderModel) StreamManyByTokenContractAddress(
+	tokenContractAddress string,
+	options *StreamOptions,
+	fn func(*models.TokenHolder) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenHolder{})
+
+	db = db.Order("value_decimal desc")
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Columns
+	db = applyStreamOptions(db, options, "", "")
+
+	tokenHolder := &models.TokenHolder{}
+	return streamRows(db, tokenHolder, func() error {
+		return fn(tokenHolder)
+	})
+}
+
 // CountByTokenContract - select from blockCounts table
 // NOTE very slow operation
 func (m *TokenHolderModel) CountByTokenContract(tokenContractAddress string) (int64, error) {
@@ -149,6 +246,152 @@ func (m *TokenHolderModel) UpsertOne(
 	return db.Error
 }
 
+// UpdateValueIfUnchanged - set the value of a token holder only if the stored value is still oldValue
+// Used by reconciliation to avoid overwriting deltas applied since the balance was read
+// Returns: was updated, error (if present)
+func (m *TokenHolderModel) UpdateValueIfUnchanged(
+	tokenContractAddress string,
+	holderAddress string,
+	oldValue string,
+	newValue string,
+	newValueDecimal float64,
+) (bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenHolder{})
+
+	// Token Contract Address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Holder Address
+	db = db.Where("holder_address = ?", holderAddress)
+
+	// Value
+	db = db.Where("value = ?", oldValue)
+
+	db = db.Updates(map[string]interface{}{
+		"value":         newValue,
+		"value_decimal": newValueDecimal,
+	})
+
+	return db.RowsAffected > 0, db.Error
+}
+
+// applyTransfer - subtract a transfer from the sender balance and add it to the receiver balance
+// NOTE must only be called once per transfer, in the transaction inserting it
+func (m *TokenHolderModel) applyTransfer(
+	db *gorm.DB,
+	tokenTransfer *models.TokenTransfer,
+) error {
+
+	if tokenTransfer.TokenContractAddress == "" {
+		// Empty reloaded transfer
+		return nil
+	}
+
+	value, err := hexToBigInt(tokenTransfer.Value)
+	if err != nil {
+		return err
+	}
+
+	decimalBase, err := getTokenDecimalBase(tokenTransfer.TokenContractAddress)
+	if err != nil {
+		return err
+	}
+
+	// From address
+	if tokenTransfer.FromAddress != tokenZeroAddress {
+		err = m.applyDelta(
+			db,
+			tokenTransfer.TokenContractAddress,
+			tokenTransfer.FromAddress,
+			new(big.Int).Neg(value),
+			decimalBase,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// To address
+	if tokenTransfer.ToAddress != tokenZeroAddress {
+		err = m.applyDelta(
+			db,
+			tokenTransfer.TokenContractAddress,
+			tokenTransfer.ToAddress,
+			value,
+			decimalBase,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getTokenDecimalBase - decimals of a token contract, defaulted to 18
+// NOTE decimals are cached in redis by the logs transformer before the transfer is loaded
+func getTokenDecimalBase(tokenContractAddress string) (int, error) {
+	decimals, err := redis.GetRedisClient().GetCount(config.Config.RedisKeyPrefix + "token_contract_decimals_" + tokenContractAddress)
+	if err != nil {
+		// Redis error
+		return 0, err
+	} else if decimals == -1 {
+		zap.S().Warn("TokenHolder: No decimals cached for token contract ", tokenContractAddress, ", defaulting to 18")
+		return 18, nil
+	}
+
+	return int(decimals), nil
+}
+
+// applyDelta - add delta to a holder balance
+// NOTE values are hex strings, the row is locked while the new balance is computed
+func (m *TokenHolderModel) applyDelta(
+	db *gorm.DB,
+	tokenContractAddress string,
+	holderAddress string,
+	delta *big.Int,
+	decimalBase int,
+) error {
+
+	// Create empty balance
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.TokenHolder{
+		TokenContractAddress: tokenContractAddress,
+		HolderAddress:        holderAddress,
+		Value:                bigIntToHex(big.NewInt(0)),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	// Lock balance
+	curTokenHolder := &models.TokenHolder{}
+	err = db.Model(&models.TokenHolder{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_contract_address = ?", tokenContractAddress).
+		Where("holder_address = ?", holderAddress).
+		First(curTokenHolder).Error
+	if err != nil {
+		return err
+	}
+
+	balance, err := hexToBigInt(curTokenHolder.Value)
+	if err != nil {
+		return err
+	}
+	balance = balance.Add(balance, delta)
+
+	return db.Model(&models.TokenHolder{}).
+		Where("token_contract_address = ?", tokenContractAddress).
+		Where("holder_address = ?", holderAddress).
+		Updates(map[string]interface{}{
+			"value":         bigIntToHex(balance),
+			"value_decimal": bigIntToFloat64(balance, decimalBase),
+		}).Error
+}
+
 // StartTokenHolderLoader starts loader
 func StartTokenHolderLoader() {
 	go func() {
@@ -0,0 +1,514 @@
+package crud
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// TokenHolderCheckpointModel - type for tokenHolderCheckpoint table model
+type TokenHolderCheckpointModel struct {
+	db            *gorm.DB
+	model         *models.TokenHolderCheckpoint
+	modelORM      *models.TokenHolderCheckpointORM
+	modelBlockORM *models.TokenHolderCheckpointBlockORM
+}
+
+var tokenHolderCheckpointModel *TokenHolderCheckpointModel
+var tokenHolderCheckpointModelOnce sync.Once
+
+// GetTokenHolderCheckpointModel - create and/or return the tokenHolderCheckpoints table model
+func GetTokenHolderCheckpointModel() *TokenHolderCheckpointModel {
+	tokenHolderCheckpointModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		tokenHolderCheckpointModel = &TokenHolderCheckpointModel{
+			db:    dbConn,
+			model: &models.TokenHolderCheckpoint{},
+		}
+	})
+
+	return tokenHolderCheckpointModel
+}
+
+// SelectLatestBlockNumber - select the latest completed checkpoint at or below maxBlockNumber
+// Returns: block number (0 if no checkpoint), error (if present)
+func (m *TokenHolderCheckpointModel) SelectLatestBlockNumber(maxBlockNumber uint64) (uint64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenHolderCheckpointBlock{})
+
+	// Max Block Number
+	if maxBlockNumber != 0 {
+		db = db.Where("block_number <= ?", maxBlockNumber)
+	}
+
+	blockNumber := uint64(0)
+	db = db.Select("COALESCE(MAX(block_number), 0)").Scan(&blockNumber)
+
+	return blockNumber, db.Error
+}
+
+// NOTE token transfers loaded at or below a checkpoint invalidate it, see invalidate
+// The lock orders them with the checkpoint builds
+const tokenHolderCheckpointsLockKey = "token_holder_checkpoints"
+
+// BuildCheckpoint - write the balances changed by token transfers in (fromBlockNumber, toBlockNumber]
+// NOTE fromBlockNumber must be the latest completed checkpoint
+func (m *TokenHolderCheckpointModel) BuildCheckpoint(fromBlockNumber uint64, toBlockNumber uint64) error {
+
+	// Token transfers in range
+	transferCount, err := m.countTokenTransfers(m.db, fromBlockNumber, toBlockNumber)
+	if err != nil {
+		return err
+	}
+
+	// Deltas
+	deltas := map[tokenHolderKey]*big.Int{}
+	err = sumTokenTransferDeltas(
+		deltas,
+		m.db.Where("block_number > ? AND block_number <= ?", fromBlockNumber, toBlockNumber),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+
+	// Previous balances
+	tokenHolderCheckpoints := []models.TokenHolderCheckpoint{}
+	for key, delta := range deltas {
+		db := m.db
+
+		// Set table
+		db = db.Model(&models.TokenHolderCheckpoint{})
+
+		db = db.Where("token_contract_address = ?", key.tokenContractAddress)
+		db = db.Where("holder_address = ?", key.holderAddress)
+		db = db.Where("block_number <= ?", fromBlockNumber)
+		db = db.Order("block_number desc")
+
+		balance := big.NewInt(0)
+
+		prevTokenHolderCheckpoint := &models.TokenHolderCheckpoint{}
+		db = db.First(prevTokenHolderCheckpoint)
+		if db.Error == nil {
+			balance, err = hexToBigInt(prevTokenHolderCheckpoint.Value)
+			if err != nil {
+				return err
+			}
+		} else if !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			// Postgres error
+			return db.Error
+		}
+
+		balance = balance.Add(balance, delta)
+
+		decimalBase, err := getTokenDecimalBase(key.tokenContractAddress)
+		if err != nil {
+			return err
+		}
+
+		tokenHolderCheckpoints = append(tokenHolderCheckpoints, models.TokenHolderCheckpoint{
+			TokenContractAddress: key.tokenContractAddress,
+			HolderAddress:        key.holderAddress,
+			BlockNumber:          toBlockNumber,
+			Value:                bigIntToHex(balance),
+			ValueDecimal:         bigIntToFloat64(balance, decimalBase),
+		})
+	}
+
+	// Write checkpoint and mark the block completed in one transaction
+	return m.db.Transaction(func(tx *gorm.DB) error {
+
+		// Wait for token transfers being loaded
+		err := advisoryTransactionLock(tx, tokenHolderCheckpointsLockKey, false)
+		if err != nil {
+			return err
+		}
+
+		// Token transfers loaded in range since the deltas were summed
+		lockedTransferCount, err := m.countTokenTransfers(tx, fromBlockNumber, toBlockNumber)
+		if err != nil {
+			return err
+		}
+		if lockedTransferCount != transferCount {
+			return errors.New("Token transfers loaded while building checkpoint")
+		}
+
+		if len(tokenHolderCheckpoints) > 0 {
+			err := tx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(&tokenHolderCheckpoints, 1000).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.TokenHolderCheckpointBlock{
+			BlockNumber: toBlockNumber,
+		}).Error
+	})
+}
+
+// invalidate - delete the checkpoints at or above the block of a token transfer
+// NOTE runs in the transaction loading the token transfer, the routine rebuilds them
+func (m *TokenHolderCheckpointModel) invalidate(tx *gorm.DB, blockNumber uint64) error {
+
+	// Wait for checkpoints being built
+	err := advisoryTransactionLock(tx, tokenHolderCheckpointsLockKey, true)
+	if err != nil {
+		return err
+	}
+
+	// Completed blocks
+	db := tx.Where("block_number >= ?", blockNumber).Delete(&models.TokenHolderCheckpointBlock{})
+	if db.Error != nil {
+		return db.Error
+	}
+	if db.RowsAffected == 0 {
+		// No checkpoint
+		return nil
+	}
+
+	zap.S().Info("BlockNumber=", blockNumber, " - Token transfer loaded late, deleting ", db.RowsAffected, " token holder checkpoints")
+
+	// Balances
+	return tx.Where("block_number >= ?", blockNumber).Delete(&models.TokenHolderCheckpoint{}).Error
+}
+
+// countTokenTransfers - count token transfers in (fromBlockNumber, toBlockNumber]
+func (m *TokenHolderCheckpointModel) countTokenTransfers(db *gorm.DB, fromBlockNumber uint64, toBlockNumber uint64) (int64, error) {
+
+	// Set table
+	db = db.Model(&models.TokenTransfer{})
+
+	db = db.Where("block_number > ? AND block_number <= ?", fromBlockNumber, toBlockNumber)
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// SelectManyByTokenContractAddressAtBlock - token holder balances of a token contract at a block
+// Returns: models sorted by balance, error (if present)
+// NOTE a limit of 0 selects all
+func (m *TokenHolderCheckpointModel) SelectManyByTokenContractAddressAtBlock(
+	limit int,
+	skip int,
+	tokenContractAddress string,
+	blockNumber uint64,
+) (*[]models.TokenHolder, error) {
+	return m.selectManyAtBlock(limit, skip, tokenHolderFilter{tokenContractAddress: tokenContractAddress}, blockNumber)
+}
+
+// CountByTokenContractAddressAtBlock - count token holders of a token contract at a block
+func (m *TokenHolderCheckpointModel) CountByTokenContractAddressAtBlock(
+	tokenContractAddress string,
+	blockNumber uint64,
+) (int64, error) {
+	return m.countAtBlock(tokenHolderFilter{tokenContractAddress: tokenContractAddress}, blockNumber)
+}
+
+// SelectManyByHolderAddressAtBlock - token balances of a holder address at a block
+// Returns: models sorted by balance, error (if present)
+// NOTE a limit of 0 selects all
+func (m *TokenHolderCheckpointModel) SelectManyByHolderAddressAtBlock(
+	limit int,
+	skip int,
+	holderAddress string,
+	tokenContractAddress string,
+	blockNumber uint64,
+) (*[]models.TokenHolder, error) {
+	return m.selectManyAtBlock(limit, skip, tokenHolderFilter{tokenContractAddress, holderAddress}, blockNumber)
+}
+
+// CountByHolderAddressAtBlock - count token balances of a holder address at a block
+func (m *TokenHolderCheckpointModel) CountByHolderAddressAtBlock(
+	holderAddress string,
+	tokenContractAddress string,
+	blockNumber uint64,
+) (int64, error) {
+	return m.countAtBlock(tokenHolderFilter{tokenContractAddress, holderAddress}, blockNumber)
+}
+
+// tokenHolderFilter - token contract address and/or holder address, empty to match all
+type tokenHolderFilter struct {
+	tokenContractAddress string
+	holderAddress        string
+}
+
+// where - add the filter to db, holderColumn is the column of the holder address
+func (f tokenHolderFilter) where(db *gorm.DB, holderColumn string) *gorm.DB {
+	if f.tokenContractAddress != "" {
+		db = db.Where("token_contract_address = ?", f.tokenContractAddress)
+	}
+	if f.holderAddress != "" {
+		db = db.Where(holderColumn+" = ?", f.holderAddress)
+	}
+
+	return db
+}
+
+// balancesAtBlock - query of the decimal balance of every holder matching filter at a block
+// Returns: query, latest checkpoint at or below blockNumber, error (if present)
+// NOTE the latest checkpoint row per holder plus the token transfers after it, summed in postgres
+func (m *TokenHolderCheckpointModel) balancesAtBlock(
+	filter tokenHolderFilter,
+	blockNumber uint64,
+) (*gorm.DB, uint64, error) {
+
+	checkpointBlockNumber, err := m.SelectLatestBlockNumber(blockNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Checkpoint balances
+	checkpointDB := m.db.Model(&models.TokenHolderCheckpoint{})
+	checkpointDB = checkpointDB.Select("DISTINCT ON (token_contract_address, holder_address) token_contract_address, holder_address, value_decimal")
+	checkpointDB = filter.where(checkpointDB, "holder_address")
+	checkpointDB = checkpointDB.Where("block_number <= ?", checkpointBlockNumber)
+	checkpointDB = checkpointDB.Order("token_contract_address, holder_address, block_number desc")
+
+	// Deltas since checkpoint
+	toDB := m.db.Model(&models.TokenTransfer{})
+	toDB = toDB.Select("token_contract_address, to_address AS holder_address, value_decimal")
+	toDB = filter.where(toDB, "to_address")
+	toDB = toDB.Where("to_address != ?", tokenZeroAddress)
+	toDB = toDB.Where("block_number > ? AND block_number <= ?", checkpointBlockNumber, blockNumber)
+
+	fromDB := m.db.Model(&models.TokenTransfer{})
+	fromDB = fromDB.Select("token_contract_address, from_address AS holder_address, -value_decimal AS value_decimal")
+	fromDB = filter.where(fromDB, "from_address")
+	fromDB = fromDB.Where("from_address != ?", tokenZeroAddress)
+	fromDB = fromDB.Where("block_number > ? AND block_number <= ?", checkpointBlockNumber, blockNumber)
+
+	// Balances
+	db := m.db.Table("(? UNION ALL ? UNION ALL ?) AS balances", checkpointDB, toDB, fromDB)
+	db = db.Select("token_contract_address, holder_address, SUM(value_decimal) AS value_decimal")
+	db = db.Group("token_contract_address, holder_address")
+	db = db.Having("SUM(value_decimal) > 0")
+
+	return db, checkpointBlockNumber, nil
+}
+
+// selectManyAtBlock - page of the token holder balances matching filter at a block
+// NOTE pages are sorted by value_decimal, values are then computed exactly
+func (m *TokenHolderCheckpointModel) selectManyAtBlock(
+	limit int,
+	skip int,
+	filter tokenHolderFilter,
+	blockNumber uint64,
+) (*[]models.TokenHolder, error) {
+
+	db, checkpointBlockNumber, err := m.balancesAtBlock(filter, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Highest balances first
+	db = db.Order("value_decimal desc, token_contract_address, holder_address")
+
+	// Limit
+	if limit != 0 {
+		db = db.Limit(limit)
+	}
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	tokenHolders := &[]models.TokenHolder{}
+	db = db.Scan(tokenHolders)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if len(*tokenHolders) == 0 {
+		return tokenHolders, nil
+	}
+
+	// Page holders
+	keys := [][]interface{}{}
+	tokenContractAddresses := []string{}
+	holderAddresses := []string{}
+	for i := range *tokenHolders {
+		t := &(*tokenHolders)[i]
+
+		keys = append(keys, []interface{}{t.TokenContractAddress, t.HolderAddress})
+		tokenContractAddresses = append(tokenContractAddresses, t.TokenContractAddress)
+		holderAddresses = append(holderAddresses, t.HolderAddress)
+	}
+
+	// Checkpoint balances
+	balances, err := m.selectCheckpointBalances(
+		m.db.Where("(token_contract_address, holder_address) IN ?", keys),
+		checkpointBlockNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deltas since checkpoint
+	transferDB := m.db.Where("token_contract_address IN ?", tokenContractAddresses)
+	transferDB = transferDB.Where("(from_address IN ? OR to_address IN ?)", holderAddresses, holderAddresses)
+	transferDB = transferDB.Where("block_number > ? AND block_number <= ?", checkpointBlockNumber, blockNumber)
+	err = sumTokenTransferDeltas(balances, transferDB, filter.holderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exact values
+	// NOTE balances summed to zero are removed
+	exactTokenHolders := make([]models.TokenHolder, 0, len(*tokenHolders))
+	for i := range *tokenHolders {
+		t := &(*tokenHolders)[i]
+
+		balance, ok := balances[tokenHolderKey{t.TokenContractAddress, t.HolderAddress}]
+		if ok == false || balance.Sign() == 0 {
+			continue
+		}
+
+		decimalBase, err := getTokenDecimalBase(t.TokenContractAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		exactTokenHolders = append(exactTokenHolders, models.TokenHolder{
+			TokenContractAddress: t.TokenContractAddress,
+			HolderAddress:        t.HolderAddress,
+			Value:                bigIntToHex(balance),
+			ValueDecimal:         bigIntToFloat64(balance, decimalBase),
+		})
+	}
+
+	return &exactTokenHolders, nil
+}
+
+// countAtBlock - count the token holders matching filter at a block
+func (m *TokenHolderCheckpointModel) countAtBlock(
+	filter tokenHolderFilter,
+	blockNumber uint64,
+) (int64, error) {
+
+	balancesDB, _, err := m.balancesAtBlock(filter, blockNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	db := m.db.Table("(?) AS token_holders", balancesDB)
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// selectCheckpointBalances - latest checkpoint balance at or below blockNumber for every holder matching db
+func (m *TokenHolderCheckpointModel) selectCheckpointBalances(
+	db *gorm.DB,
+	blockNumber uint64,
+) (map[tokenHolderKey]*big.Int, error) {
+	balances := map[tokenHolderKey]*big.Int{}
+
+	if blockNumber == 0 {
+		// No checkpoint
+		return balances, nil
+	}
+
+	// Set table
+	db = db.Model(&models.TokenHolderCheckpoint{})
+
+	db = db.Where("block_number <= ?", blockNumber)
+
+	// Latest row per holder
+	db = db.Select("DISTINCT ON (token_contract_address, holder_address) token_contract_address, holder_address, block_number, value")
+	db = db.Order("token_contract_address, holder_address, block_number desc")
+
+	tokenHolderCheckpoints := &[]models.TokenHolderCheckpoint{}
+	db = db.Find(tokenHolderCheckpoints)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	for i := range *tokenHolderCheckpoints {
+		t := &(*tokenHolderCheckpoints)[i]
+
+		balance, err := hexToBigInt(t.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[tokenHolderKey{t.TokenContractAddress, t.HolderAddress}] = balance
+	}
+
+	return balances, nil
+}
+
+type tokenHolderKey struct {
+	tokenContractAddress string
+	holderAddress        string
+}
+
+// sumTokenTransferDeltas - add the balance changes of every token transfer matching db to balances
+// NOTE if holderAddress is set, only changes to that holder are added
+func sumTokenTransferDeltas(
+	balances map[tokenHolderKey]*big.Int,
+	db *gorm.DB,
+	holderAddress string,
+) error {
+
+	// Set table
+	db = db.Model(&models.TokenTransfer{})
+
+	db = db.Select("token_contract_address, from_address, to_address, value")
+
+	// Stream rows to keep memory constant
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	addDelta := func(key tokenHolderKey, delta *big.Int) {
+		if key.holderAddress == tokenZeroAddress {
+			return
+		}
+		if holderAddress != "" && key.holderAddress != holderAddress {
+			return
+		}
+
+		balance, ok := balances[key]
+		if ok == false {
+			balance = big.NewInt(0)
+			balances[key] = balance
+		}
+		balance.Add(balance, delta)
+	}
+
+	for rows.Next() {
+		var tokenContractAddress, fromAddress, toAddress, valueHex string
+		err = rows.Scan(&tokenContractAddress, &fromAddress, &toAddress, &valueHex)
+		if err != nil {
+			return err
+		}
+
+		value, err := hexToBigInt(valueHex)
+		if err != nil {
+			return err
+		}
+
+		addDelta(tokenHolderKey{tokenContractAddress, fromAddress}, new(big.Int).Neg(value))
+		addDelta(tokenHolderKey{tokenContractAddress, toAddress}, value)
+	}
+
+	return rows.Err()
+}
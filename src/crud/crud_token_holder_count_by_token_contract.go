@@ -36,24 +36,12 @@ func GetTokenHolderCountByTokenContractModel() *TokenHolderCountByTokenContractM
 			LoaderChannel: make(chan *models.TokenHolderCountByTokenContract, 1),
 		}
 
-		err := tokenHolderCountByTokenContractModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TokenHolderCountByTokenContractModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTokenHolderCountByTokenContractLoader()
 	})
 
 	return tokenHolderCountByTokenContractModel
 }
 
-// Migrate - migrate tokenHolderCountByTokenContracts table
-func (m *TokenHolderCountByTokenContractModel) Migrate() error {
-	// Only using TokenHolderCountByTokenContractRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // SelectMany - select from token_transfers table
 // Returns: models, error (if present)
 func (m *TokenHolderCountByTokenContractModel) SelectOne(
@@ -94,6 +82,23 @@ func (m *TokenHolderCountByTokenContractModel) SelectCount(tokenContractAddress
 	return count, db.Error
 }
 
+// SelectManyByTokenContractAddresses - select counts of many token contracts from token_holder_count_by_token_contracts table
+// NOTE token contracts without a count are omitted
+func (m *TokenHolderCountByTokenContractModel) SelectManyByTokenContractAddresses(tokenContractAddresses []string) (*[]models.TokenHolderCountByTokenContract, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenHolderCountByTokenContract{})
+
+	// Addresses
+	db = db.Where("token_contract_address IN ?", tokenContractAddresses)
+
+	tokenHolderCountByTokenContracts := &[]models.TokenHolderCountByTokenContract{}
+	db = db.Find(tokenHolderCountByTokenContracts)
+
+	return tokenHolderCountByTokenContracts, db.Error
+}
+
 func (m *TokenHolderCountByTokenContractModel) UpsertOne(
 	tokenHolderCountByTokenContract *models.TokenHolderCountByTokenContract,
 ) error {
@@ -132,6 +137,32 @@ func StartTokenHolderCountByTokenContractLoader() {
 				// Postgres error
 				zap.S().Fatal("Loader=TokenHolderCountByTokenContract, TokenContractAddress=", newTokenHolderCountByTokenContract.TokenContractAddress, " - Error: ", err.Error())
 			}
+
+			///////////
+			// Stats //
+			///////////
+			// NOTE bucketed by the latest token transfer of the contract
+			tokenTransfers, err := GetTokenTransferModel().SelectManyLatestByTokenContractAddresses(
+				[]string{newTokenHolderCountByTokenContract.TokenContractAddress},
+			)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=TokenHolderCountByTokenContract, TokenContractAddress=", newTokenHolderCountByTokenContract.TokenContractAddress, " - Error: ", err.Error())
+			}
+			if len(*tokenTransfers) == 0 || (*tokenTransfers)[0].BlockTimestamp == 0 {
+				// No token transfers
+				continue
+			}
+
+			err = GetTokenContractStatModel().UpdateHolderCount(
+				newTokenHolderCountByTokenContract.TokenContractAddress,
+				newTokenHolderCountByTokenContract.Count,
+				(*tokenTransfers)[0].BlockTimestamp,
+			)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=TokenHolderCountByTokenContract, TokenContractAddress=", newTokenHolderCountByTokenContract.TokenContractAddress, " - Error: ", err.Error())
+			}
 		}
 	}()
 }
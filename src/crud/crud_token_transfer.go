@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
 )
 
 // TokenTransferModel - type for tokenTransfer table model
@@ -18,6 +19,7 @@ type TokenTransferModel struct {
 	model         *models.TokenTransfer
 	modelORM      *models.TokenTransferORM
 	LoaderChannel chan *models.TokenTransfer
+	partitions    *partitionedTable
 }
 
 var tokenTransferModel *TokenTransferModel
@@ -35,11 +37,13 @@ func GetTokenTransferModel() *TokenTransferModel {
 			db:            dbConn,
 			model:         &models.TokenTransfer{},
 			LoaderChannel: make(chan *models.TokenTransfer, 1),
+			partitions:    newPartitionedTable(dbConn, "token_transfers", "transaction_hash", "log_index"),
 		}
 
-		err := tokenTransferModel.Migrate()
+		// NOTE tables are migrated by the migrate command
+		err := tokenTransferModel.partitions.refresh()
 		if err != nil {
-			zap.S().Fatal("TokenTransferModel: Unable migrate postgres table: ", err.Error())
+			zap.S().Fatal("TokenTransferModel: Unable to read postgres table partitions: ", err.Error())
 		}
 
 		StartTokenTransferLoader()
@@ -48,13 +52,6 @@ func GetTokenTransferModel() *TokenTransferModel {
 	return tokenTransferModel
 }
 
-// Migrate - migrate tokenTransfers table
-func (m *TokenTransferModel) Migrate() error {
-	// Only using TokenTransferRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // SelectOne - select from token_transfers table
 // Returns: models, error (if present)
 func (m *TokenTransferModel) SelectOne(
@@ -88,6 +85,8 @@ func (m *TokenTransferModel) SelectMany(
 	blockNumber int,
 	startBlockNumber int,
 	endBlockNumber int,
+	startTimestamp int64,
+	endTimestamp int64,
 	transactionHash string,
 	tokenContractAddress string,
 ) (*[]models.TokenTransfer, error) {
@@ -124,6 +123,16 @@ func (m *TokenTransferModel) SelectMany(
 		db = db.Where("block_number <= ?", endBlockNumber)
 	}
 
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
 	// transaction hash
 	if transactionHash != "" {
 		db = db.Where("transaction_hash = ?", transactionHash)
@@ -154,6 +163,8 @@ func (m *TokenTransferModel) SelectManyByAddress(
 	limit int,
 	skip int,
 	address string,
+	startBlockNumber int,
+	endBlockNumber int,
 ) (*[]models.TokenTransfer, error) {
 	db := m.db
 
@@ -164,18 +175,21 @@ func (m *TokenTransferModel) SelectManyByAddress(
 	db = db.Order("block_number desc")
 
 	// Address
-	db = db.Where(`(transaction_hash, log_index)
-	IN (
-		SELECT
-			transaction_hash, log_index
-		FROM
-			token_transfer_count_by_address_indices
-		WHERE
-			address = ?
-		ORDER BY block_number desc
-		LIMIT ?
-		OFFSET ?
-	)`, address, limit, skip)
+	subQuery := m.db.Table("token_transfer_count_by_address_indices").Select("transaction_hash, log_index")
+	subQuery = subQuery.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number <= ?", endBlockNumber)
+	}
+
+	subQuery = subQuery.Order("block_number desc").Limit(limit).Offset(skip)
+	db = db.Where("(transaction_hash, log_index) IN (?)", subQuery)
 
 	tokenTransfers := &[]models.TokenTransfer{}
 	db = db.Find(tokenTransfers)
@@ -189,6 +203,8 @@ func (m *TokenTransferModel) SelectManyByTokenContractAddress(
 	limit int,
 	skip int,
 	tokenContractAddress string,
+	startTimestamp int64,
+	endTimestamp int64,
 ) (*[]models.TokenTransfer, error) {
 	db := m.db
 
@@ -201,6 +217,16 @@ func (m *TokenTransferModel) SelectManyByTokenContractAddress(
 	// address
 	db = db.Where("token_contract_address = ?", tokenContractAddress)
 
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
 	// Limit is required and defaulted to 1
 	db = db.Limit(limit)
 
@@ -215,6 +241,165 @@ func (m *TokenTransferModel) SelectManyByTokenContractAddress(
 	return tokenTransfers, db.Error
 }
 
+// SelectManyByTransactionHashes - select the token transfers of many transactions
+// Returns: models, error (if present)
+func (m *TokenTransferModel) SelectManyByTransactionHashes(
+	transactionHashes []string,
+) (*[]models.TokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	db = db.Order("transaction_hash, log_index")
+
+	// Transaction Hashes
+	db = db.Where("transaction_hash IN ?", transactionHashes)
+
+	tokenTransfers := &[]models.TokenTransfer{}
+	db = db.Find(tokenTransfers)
+
+	return tokenTransfers, db.Error
+}
+
+// SelectManyLatestByTokenContractAddresses - select the latest transfer of many token contracts
+// NOTE used for the token contract name and symbol
+func (m *TokenTransferModel) SelectManyLatestByTokenContractAddresses(
+	tokenContractAddresses []string,
+) (*[]models.TokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	// Latest per token contract
+	db = db.Select("DISTINCT ON (token_contract_address) *")
+	db = db.Order("token_contract_address, block_number desc")
+
+	// Token Contract Addresses
+	db = db.Where("token_contract_address IN ?", tokenContractAddresses)
+
+	tokenTransfers := &[]models.TokenTransfer{}
+	db = db.Find(tokenTransfers)
+
+	return tokenTransfers, db.Error
+}
+
+// StreamMany - stream from token_transfers table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TokenTransferModel) StreamMany(
+	from string,
+	to string,
+	blockNumber int,
+	transactionHash string,
+	tokenContractAddress string,
+	options *StreamOptions,
+	fn func(*models.TokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	// Latest transactions first
+	db = db.Order("block_number desc")
+
+	// from
+	if from != "" {
+		db = db.Where("from_address = ?", from)
+	}
+
+	// to
+	if to != "" {
+		db = db.Where("to_address = ?", to)
+	}
+
+	// block number
+	if blockNumber != 0 {
+		db = db.Where("block_number = ?", blockNumber)
+	}
+
+	// transaction hash
+	if transactionHash != "" {
+		db = db.Where("transaction_hash = ?", transactionHash)
+	}
+
+	// token contract address
+	if tokenContractAddress != "" {
+		db = db.Where("token_contract_address = ?", tokenContractAddress)
+	}
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	tokenTransfer := &models.TokenTransfer{}
+	return streamRows(db, tokenTransfer, func() error {
+		return fn(tokenTransfer)
+	})
+}
+
+// StreamManyByAddress - stream from token_transfers table by address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TokenTransferModel) StreamManyByAddress(
+	address string,
+	options *StreamOptions,
+	fn func(*models.TokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	// Latest transactions first
+	db = db.Order("block_number desc")
+
+	// Address
+	db = db.Where(`(transaction_hash, log_index)
+	IN (
+		SELECT
+			transaction_hash, log_index
+		FROM
+			token_transfer_count_by_address_indices
+		WHERE
+			address = ?
+	)`, address)
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	tokenTransfer := &models.TokenTransfer{}
+	return streamRows(db, tokenTransfer, func() error {
+		return fn(tokenTransfer)
+	})
+}
+
+// StreamManyByTokenContractAddress - stream from token_transfers table by token contract address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TokenTransferModel) StreamManyByTokenContractAddress(
+	tokenContractAddress string,
+	options *StreamOptions,
+	fn func(*models.TokenTransfer) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	// Latest transactions first
+	db = db.Order("block_number desc")
+
+	// address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	tokenTransfer := &models.TokenTransfer{}
+	return streamRows(db, tokenTransfer, func() error {
+		return fn(tokenTransfer)
+	})
+}
+
 // SelectManyDistinctTokenContracts - select from token_transfers
 func (m *TokenTransferModel) SelectManyDistinctTokenContracts(
 	limit int,
@@ -242,6 +427,37 @@ func (m *TokenTransferModel) SelectManyDistinctTokenContracts(
 	return tokenTransfers, db.Error
 }
 
+// SelectManyDistinctTokenContractsAfter - select from token_transfers in token contract order
+// Used by routines resuming from the last token contract processed
+// Returns: models, error (if present)
+func (m *TokenTransferModel) SelectManyDistinctTokenContractsAfter(
+	limit int,
+	tokenContractAddress string,
+) (*[]models.TokenTransfer, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransfer{})
+
+	// Distinct
+	db = db.Distinct("token_contract_address")
+
+	// Token contract address
+	if tokenContractAddress != "" {
+		db = db.Where("token_contract_address > ?", tokenContractAddress)
+	}
+
+	db = db.Order("token_contract_address")
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	tokenTransfers := &[]models.TokenTransfer{}
+	db = db.Find(tokenTransfers)
+
+	return tokenTransfers, db.Error
+}
+
 // SelectManyDistinctFromAddress - select from token_transfers
 func (m *TokenTransferModel) SelectManyDistinctFromAddresses(
 	limit int,
@@ -310,6 +526,113 @@ func (m *TokenTransferModel) Count() (int64, error) {
 	return count, db.Error
 }
 
+// SelectMaxBlockNumber - highest block number with a token transfer
+func (m *TokenTransferModel) SelectMaxBlockNumber() (uint64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenTransfer{})
+
+	blockNumber := uint64(0)
+	db = db.Select("COALESCE(MAX(block_number), 0)").Scan(&blockNumber)
+
+	return blockNumber, db.Error
+}
+
+// ExistsByHolderAfterBlock - if a holder has token transfers above a block number
+func (m *TokenTransferModel) ExistsByHolderAfterBlock(
+	tokenContractAddress string,
+	holderAddress string,
+	blockNumber uint64,
+) (bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenTransfer{})
+
+	// Token contract address
+	db = db.Where("token_contract_address = ?", tokenContractAddress)
+
+	// Holder address
+	db = db.Where("(from_address = ? OR to_address = ?)", holderAddress, holderAddress)
+
+	// Block number
+	db = db.Where("block_number > ?", blockNumber)
+
+	count := int64(0)
+	db = db.Limit(1).Count(&count)
+
+	return count > 0, db.Error
+}
+
+// CountMany - count token transfers matching filters
+// NOTE counts above maxCount are estimated
+// Returns: count, is estimated, error (if present)
+func (m *TokenTransferModel) CountMany(
+	from string,
+	to string,
+	blockNumber int,
+	startBlockNumber int,
+	endBlockNumber int,
+	startTimestamp int64,
+	endTimestamp int64,
+	transactionHash string,
+	tokenContractAddress string,
+	maxCount int64,
+) (int64, bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenTransfer{})
+
+	// from
+	if from != "" {
+		db = db.Where("from_address = ?", from)
+	}
+
+	// to
+	if to != "" {
+		db = db.Where("to_address = ?", to)
+	}
+
+	// block number
+	if blockNumber != 0 {
+		db = db.Where("block_number = ?", blockNumber)
+	}
+
+	// start block number
+	if startBlockNumber != 0 {
+		db = db.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		db = db.Where("block_number <= ?", endBlockNumber)
+	}
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", endTimestamp)
+	}
+
+	// transaction hash
+	if transactionHash != "" {
+		db = db.Where("transaction_hash = ?", transactionHash)
+	}
+
+	// token contract address
+	if tokenContractAddress != "" {
+		db = db.Where("token_contract_address = ?", tokenContractAddress)
+	}
+
+	return countCapped(db, maxCount)
+}
+
 // CountByTokenContract - Count by token contract
 func (m *TokenTransferModel) CountByTokenContract(tokenContractAddress string) (int64, error) {
 	db := m.db
@@ -328,7 +651,13 @@ func (m *TokenTransferModel) CountByTokenContract(tokenContractAddress string) (
 func (m *TokenTransferModel) UpsertOne(
 	tokenTransfer *models.TokenTransfer,
 ) error {
-	db := m.db
+	return m.upsertOne(m.db, tokenTransfer)
+}
+
+func (m *TokenTransferModel) upsertOne(
+	db *gorm.DB,
+	tokenTransfer *models.TokenTransfer,
+) error {
 
 	// map[string]interface{}
 	updateOnConflictValues := extractFilledFieldsFromModel(
@@ -337,18 +666,94 @@ func (m *TokenTransferModel) UpsertOne(
 	)
 
 	// Upsert
-	db = db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}}, // NOTE set to primary keys for table
-		DoUpdates: clause.Assignments(updateOnConflictValues),
-	}).Create(tokenTransfer)
+	// NOTE primary keys include block_number when partitioned
+	return m.partitions.upsert(db, tokenTransfer, updateOnConflictValues)
+}
+
+// LoadOne - insert a transfer and apply it to the holder balances in one transaction
+// NOTE transfers loaded before, ex replayed by kafka, are updated without applying the deltas again
+func (m *TokenTransferModel) LoadOne(
+	tokenTransfer *models.TokenTransfer,
+) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+
+		// Rows at other blocks
+		// NOTE primary keys include block_number when partitioned
+		isLoaded, isSkipped, err := m.replaceOtherBlocks(tx, tokenTransfer)
+		if err != nil || isSkipped {
+			return err
+		}
+		if isLoaded {
+			// Loaded before at another block
+			return m.upsertOne(tx, tokenTransfer)
+		}
+
+		// Insert
+		db := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(tokenTransfer)
+		if db.Error != nil {
+			return db.Error
+		}
+
+		if db.RowsAffected == 0 {
+			// Loaded before
+			return m.upsertOne(tx, tokenTransfer)
+		}
 
-	return db.Error
+		// Checkpoints missing the transfer
+		err = GetTokenHolderCheckpointModel().invalidate(tx, tokenTransfer.BlockNumber)
+		if err != nil {
+			return err
+		}
+
+		// Holder balances
+		return GetTokenHolderModel().applyTransfer(tx, tokenTransfer)
+	})
+}
+
+// replaceOtherBlocks - delete the rows of the keys of tokenTransfer at other blocks, see partitionedTable.upsert
+// NOTE a transfer at block 0, ex an empty reloaded transfer, is skipped if a row exists at another block
+// Returns: if a deleted row was a loaded transfer, if tokenTransfer is skipped, error (if present)
+func (m *TokenTransferModel) replaceOtherBlocks(
+	tx *gorm.DB,
+	tokenTransfer *models.TokenTransfer,
+) (bool, bool, error) {
+	where, args, blockNumber, err := m.partitions.otherBlocksCondition(tx, tokenTransfer)
+	if err != nil {
+		return false, false, err
+	}
+	table := quoteIdentifier(m.partitions.name)
+
+	if blockNumber == 0 {
+		exists := false
+		err = tx.Raw("SELECT EXISTS (SELECT 1 FROM "+table+" WHERE "+where+")", args...).Row().Scan(&exists)
+
+		return false, exists, err
+	}
+
+	// NOTE empty reloaded transfers were not applied to the holder balances
+	tokenContractAddresses := []string{}
+	err = tx.Raw(
+		"DELETE FROM "+table+" WHERE "+where+" RETURNING COALESCE(token_contract_address, '')",
+		args...,
+	).Scan(&tokenContractAddresses).Error
+	if err != nil {
+		return false, false, err
+	}
+
+	for _, tokenContractAddress := range tokenContractAddresses {
+		if tokenContractAddress != "" {
+			return true, false, nil
+		}
+	}
+
+	return false, false, nil
 }
 
 // StartTokenTransferLoader starts loader
 func StartTokenTransferLoader() {
 	go func() {
 		postgresLoaderChan := GetTokenTransferModel().LoaderChannel
+		tipBlockNumber := uint64(0)
 
 		for {
 			// Read tokenTransfer
@@ -377,13 +782,38 @@ func StartTokenTransferLoader() {
 			//////////////////////
 			// Load to postgres //
 			//////////////////////
-			err = GetTokenTransferModel().UpsertOne(newTokenTransfer)
-			zap.S().Debug("Loader=TokenTransfer, Hash=", newTokenTransfer.TransactionHash, " LogIndex=", newTokenTransfer.LogIndex, " - Upserted")
+			// NOTE holder balances are applied with the insert
+			err = GetTokenTransferModel().LoadOne(newTokenTransfer)
+			zap.S().Debug("Loader=TokenTransfer, Hash=", newTokenTransfer.TransactionHash, " LogIndex=", newTokenTransfer.LogIndex, " - Loaded")
 			if err != nil {
 				// Postgres error
 				zap.S().Info("Loader=TokenTransfer, Hash=", newTokenTransfer.TransactionHash, " LogIndex=", newTokenTransfer.LogIndex, " - FATAL")
 				zap.S().Fatal(err.Error())
 			}
+
+			///////////
+			// Stats //
+			///////////
+			// NOTE reloaded transfers are skipped by the stats index
+			err = GetTransactionStatModel().ApplyTokenTransfer(newTokenTransfer)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=TokenTransfer, Hash=", newTokenTransfer.TransactionHash, " LogIndex=", newTokenTransfer.LogIndex, " - Error: ", err.Error())
+			}
+
+			/////////
+			// Tip //
+			/////////
+			// NOTE token holder balances are reconciled at this tip
+			if newTokenTransfer.BlockNumber > tipBlockNumber {
+				err = redis.GetRedisClient().SetTokenTransferTipBlockNumber(newTokenTransfer.BlockNumber)
+				if err != nil {
+					// Redis error
+					zap.S().Warn("Loader=TokenTransfer, Hash=", newTokenTransfer.TransactionHash, " LogIndex=", newTokenTransfer.LogIndex, " - Error: ", err.Error())
+				} else {
+					tipBlockNumber = newTokenTransfer.BlockNumber
+				}
+			}
 		}
 	}()
 }
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
@@ -38,24 +39,12 @@ func GetTokenTransferCountByAddressModel() *TokenTransferCountByAddressModel {
 			LoaderChannel: make(chan *models.TokenTransferCountByAddress, 1),
 		}
 
-		err := tokenTransferCountByAddressModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TokenTransferCountByAddressModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTokenTransferCountByAddressLoader()
 	})
 
 	return tokenTransferCountByAddressModel
 }
 
-// Migrate - migrate tokenTransferCountByAddresss table
-func (m *TokenTransferCountByAddressModel) Migrate() error {
-	// Only using TokenTransferCountByAddressRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from tokenTransferCountByAddresss table
 func (m *TokenTransferCountByAddressModel) SelectOne(address string) (*models.TokenTransferCountByAddress, error) {
 	db := m.db
@@ -93,6 +82,31 @@ func (m *TokenTransferCountByAddressModel) SelectMany(limit int, skip int) (*[]m
 	return tokenTransferCountByAddresses, db.Error
 }
 
+// SelectManyAfterAddress - select from token_transfer_count_by_addresses table in address order
+// Used by routines resuming from the last address processed
+// Returns: models, error (if present)
+func (m *TokenTransferCountByAddressModel) SelectManyAfterAddress(limit int, address string) (*[]models.TokenTransferCountByAddress, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransferCountByAddress{})
+
+	// Address
+	if address != "" {
+		db = db.Where("address > ?", address)
+	}
+
+	db = db.Order("address")
+
+	// Limit
+	db = db.Limit(limit)
+
+	tokenTransferCountByAddresses := &[]models.TokenTransferCountByAddress{}
+	db = db.Find(tokenTransferCountByAddresses)
+
+	return tokenTransferCountByAddresses, db.Error
+}
+
 // Select - select from tokenTransferCountByAddresss table
 func (m *TokenTransferCountByAddressModel) SelectCount(address string) (uint64, error) {
 	db := m.db
@@ -146,7 +160,7 @@ func StartTokenTransferCountByAddressLoader() {
 			//////////////////////////
 			// Get count from redis //
 			//////////////////////////
-			countKey := "icon_transactions_token_transfer_count_by_address_" + newTokenTransferCountByAddress.Address
+			countKey := config.Config.RedisKeyPrefix + "token_transfer_count_by_address_" + newTokenTransferCountByAddress.Address
 
 			count, err := redis.GetRedisClient().GetCount(countKey)
 			if err != nil {
@@ -6,7 +6,6 @@ import (
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 
 	"github.com/geometry-labs/icon-transactions/models"
 )
@@ -17,6 +16,7 @@ type TokenTransferCountByAddressIndexModel struct {
 	model         *models.TokenTransferCountByAddressIndex
 	modelORM      *models.TokenTransferCountByAddressIndexORM
 	LoaderChannel chan *models.TokenTransferCountByAddressIndex
+	partitions    *partitionedTable
 }
 
 var tokenTransferCountByAddressIndexModel *TokenTransferCountByAddressIndexModel
@@ -34,24 +34,19 @@ func GetTokenTransferCountByAddressIndexModel() *TokenTransferCountByAddressInde
 			db:            dbConn,
 			model:         &models.TokenTransferCountByAddressIndex{},
 			LoaderChannel: make(chan *models.TokenTransferCountByAddressIndex, 1),
+			partitions:    newPartitionedTable(dbConn, "token_transfer_count_by_address_indices", "transaction_hash", "log_index", "address"),
 		}
 
-		err := tokenTransferCountByAddressIndexModel.Migrate()
+		// NOTE tables are migrated by the migrate command
+		err := tokenTransferCountByAddressIndexModel.partitions.refresh()
 		if err != nil {
-			zap.S().Fatal("TokenTransferCountByAddressIndexModel: Unable migrate postgres table: ", err.Error())
+			zap.S().Fatal("TokenTransferCountByAddressIndexModel: Unable to read postgres table partitions: ", err.Error())
 		}
 	})
 
 	return tokenTransferCountByAddressIndexModel
 }
 
-// Migrate - migrate tokenTransferCountByAddressIndexs table
-func (m *TokenTransferCountByAddressIndexModel) Migrate() error {
-	// Only using TokenTransferCountByAddressIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 func (m *TokenTransferCountByAddressIndexModel) SelectMissingBlockNumbers(
 	limit int,
 ) (*[]models.TokenTransferCountByAddressIndex, error) {
@@ -89,6 +84,36 @@ func (m *TokenTransferCountByAddressIndexModel) CountByAddress(address string) (
 	return count, db.Error
 }
 
+// CountByAddressBlockRange - Count by address in a block range
+// NOTE counts above maxCount are estimated
+// Returns: count, is estimated, error (if present)
+func (m *TokenTransferCountByAddressIndexModel) CountByAddressBlockRange(
+	address string,
+	startBlockNumber int,
+	endBlockNumber int,
+	maxCount int64,
+) (int64, bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TokenTransferCountByAddressIndex{})
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		db = db.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		db = db.Where("block_number <= ?", endBlockNumber)
+	}
+
+	return countCapped(db, maxCount)
+}
+
 // Insert - Insert transactionCountByIndex into table
 func (m *TokenTransferCountByAddressIndexModel) Insert(tokenTransferCountByAddressIndex *models.TokenTransferCountByAddressIndex) error {
 	db := m.db
@@ -113,10 +138,6 @@ func (m *TokenTransferCountByAddressIndexModel) UpsertOne(
 	)
 
 	// Upsert
-	db = db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}, {Name: "address"}}, // NOTE set to primary keys for table
-		DoUpdates: clause.Assignments(updateOnConflictValues),
-	}).Create(tokenTransferCountByAddressIndex)
-
-	return db.Error
+	// NOTE primary keys include block_number when partitioned
+	return m.partitions.upsert(db, tokenTransferCountByAddressIndex, updateOnConflictValues)
 }
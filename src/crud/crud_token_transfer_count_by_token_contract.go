@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
@@ -38,24 +39,12 @@ func GetTokenTransferCountByTokenContractModel() *TokenTransferCountByTokenContr
 			LoaderChannel: make(chan *models.TokenTransferCountByTokenContract, 1),
 		}
 
-		err := tokenTransferCountByTokenContractModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TokenTransferCountByTokenContractModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTokenTransferCountByTokenContractLoader()
 	})
 
 	return tokenTransferCountByTokenContractModel
 }
 
-// Migrate - migrate tokenTransferCountByTokenContracts table
-func (m *TokenTransferCountByTokenContractModel) Migrate() error {
-	// Only using TokenTransferCountByTokenContractRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from tokenTransferCountByTokenContracts table
 func (m *TokenTransferCountByTokenContractModel) SelectOne(tokenContract string) (*models.TokenTransferCountByTokenContract, error) {
 	db := m.db
@@ -93,6 +82,23 @@ func (m *TokenTransferCountByTokenContractModel) SelectCount(tokenContract strin
 	return count, db.Error
 }
 
+// SelectManyByTokenContracts - select counts of many token contracts from token_transfer_count_by_token_contracts table
+// NOTE token contracts without a count are omitted
+func (m *TokenTransferCountByTokenContractModel) SelectManyByTokenContracts(tokenContracts []string) (*[]models.TokenTransferCountByTokenContract, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TokenTransferCountByTokenContract{})
+
+	// TokenContracts
+	db = db.Where("token_contract IN ?", tokenContracts)
+
+	tokenTransferCountByTokenContracts := &[]models.TokenTransferCountByTokenContract{}
+	db = db.Find(tokenTransferCountByTokenContracts)
+
+	return tokenTransferCountByTokenContracts, db.Error
+}
+
 func (m *TokenTransferCountByTokenContractModel) UpsertOne(
 	tokenTransferCountByTokenContract *models.TokenTransferCountByTokenContract,
 ) error {
@@ -125,7 +131,7 @@ func StartTokenTransferCountByTokenContractLoader() {
 			//////////////////////////
 			// Get count from redis //
 			//////////////////////////
-			countKey := "icon_transactions_token_transfer_count_by_token_contract_" + newTokenTransferCountByTokenContract.TokenContract
+			countKey := config.Config.RedisKeyPrefix + "token_transfer_count_by_token_contract_" + newTokenTransferCountByTokenContract.TokenContract
 
 			count, err := redis.GetRedisClient().GetCount(countKey)
 			if err != nil {
@@ -33,23 +33,11 @@ func GetTokenTransferCountByTokenContractIndexModel() *TokenTransferCountByToken
 			model:         &models.TokenTransferCountByTokenContractIndex{},
 			LoaderChannel: make(chan *models.TokenTransferCountByTokenContractIndex, 1),
 		}
-
-		err := tokenTransferCountByTokenContractIndexModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TokenTransferCountByTokenContractIndexModel: Unable migrate postgres table: ", err.Error())
-		}
 	})
 
 	return tokenTransferCountByTokenContractIndexModel
 }
 
-// Migrate - migrate tokenTransferCountByTokenContractIndexs table
-func (m *TokenTransferCountByTokenContractIndexModel) Migrate() error {
-	// Only using TokenTransferCountByTokenContractIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Insert - Insert transactionCountByIndex into table
 func (m *TokenTransferCountByTokenContractIndexModel) Insert(tokenTransferCountByTokenContractIndex *models.TokenTransferCountByTokenContractIndex) error {
 	db := m.db
@@ -9,9 +9,9 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 
 	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/redis"
 )
 
 // TransactionModel - type for transaction table model
@@ -20,6 +20,7 @@ type TransactionModel struct {
 	model         *models.Transaction
 	modelORM      *models.TransactionORM
 	LoaderChannel chan *models.Transaction
+	partitions    *partitionedTable
 }
 
 var transactionModel *TransactionModel
@@ -38,11 +39,13 @@ func GetTransactionModel() *TransactionModel {
 			model:         &models.Transaction{},
 			modelORM:      &models.TransactionORM{},
 			LoaderChannel: make(chan *models.Transaction, 1),
+			partitions:    newPartitionedTable(dbConn, "transactions", "hash", "log_index"),
 		}
 
-		err := transactionModel.Migrate()
+		// NOTE tables are migrated by the migrate command
+		err := transactionModel.partitions.refresh()
 		if err != nil {
-			zap.S().Fatal("TransactionModel: Unable migrate postgres table: ", err.Error())
+			zap.S().Fatal("TransactionModel: Unable to read postgres table partitions: ", err.Error())
 		}
 
 		StartTransactionLoader()
@@ -51,13 +54,6 @@ func GetTransactionModel() *TransactionModel {
 	return transactionModel
 }
 
-// Migrate - migrate transactions table
-func (m *TransactionModel) Migrate() error {
-	// Only using TransactionRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Insert - Insert transaction into table
 func (m *TransactionModel) Insert(transaction *models.Transaction) error {
 
@@ -140,67 +136,159 @@ func (m *TransactionModel) SelectMany(
 	return transactions, db.Error
 }
 
-// SelectManyAPI - select from transactions table
-// Returns: models, error (if present)
-func (m *TransactionModel) SelectManyAPI(
-	limit int,
-	skip int,
-	from string,
-	to string,
-	_type string,
-	blockNumber int,
-	startBlockNumber int,
-	endBlockNumber int,
-	method string,
-	sort string,
-) (*[]models.TransactionAPIList, error) {
-	db := m.db
+// TransactionFilters - filters for transaction list queries
+// NOTE empty values are not filtered on
+type TransactionFilters struct {
+	From             []string
+	To               []string
+	Address          string // from or to address
+	Type             string
+	BlockNumber      int
+	StartBlockNumber int
+	EndBlockNumber   int
+	StartTimestamp   int64 // block timestamp in micro seconds
+	EndTimestamp     int64 // block timestamp in micro seconds
+	Method           []string
+	MinValue         string // 0x prefixed hex in loop
+	MaxValue         string // 0x prefixed hex in loop
+	Status           string // success or failed
+	Sort             string // asc or desc
+	SortBy           string // value, fee, or block_number
+}
 
-	// Set table
-	db = db.Model(&[]models.Transaction{})
+// IsEmpty - no filters besides type
+func (f *TransactionFilters) IsEmpty() bool {
+	return len(f.From) == 0 &&
+		len(f.To) == 0 &&
+		f.Address == "" &&
+		f.BlockNumber == 0 &&
+		f.StartBlockNumber == 0 &&
+		f.EndBlockNumber == 0 &&
+		f.StartTimestamp == 0 &&
+		f.EndTimestamp == 0 &&
+		len(f.Method) == 0 &&
+		f.MinValue == "" &&
+		f.MaxValue == "" &&
+		f.Status == ""
+}
 
-	// Latest transactions first
-	if sort != "" {
-		db = db.Order("block_number " + sort + ", transaction_index")
+// applyTransactionOrder - add the order of a transactions query
+func applyTransactionOrder(db *gorm.DB, filters *TransactionFilters) *gorm.DB {
+	if filters.Sort != "" {
+		switch filters.SortBy {
+		case "value":
+			db = db.Order(hexColumnOrder("value", filters.Sort) + ", block_number " + filters.Sort)
+		case "fee":
+			db = db.Order(hexColumnOrder("transaction_fee", filters.Sort) + ", block_number " + filters.Sort)
+		default:
+			// Latest transactions first
+			db = db.Order("block_number " + filters.Sort + ", transaction_index")
+		}
 	} else {
 		db = db.Order("transaction_index")
 	}
 
+	return db
+}
+
+// applyTransactionFilters - add filters to a transactions query
+func applyTransactionFilters(db *gorm.DB, filters *TransactionFilters) *gorm.DB {
+
 	// from
-	if from != "" {
-		db = db.Where("from_address = ?", from)
+	if len(filters.From) == 1 {
+		db = db.Where("from_address = ?", filters.From[0])
+	} else if len(filters.From) > 1 {
+		db = db.Where("from_address IN ?", filters.From)
 	}
 
 	// to
-	if to != "" {
-		db = db.Where("to_address = ?", to)
+	if len(filters.To) == 1 {
+		db = db.Where("to_address = ?", filters.To[0])
+	} else if len(filters.To) > 1 {
+		db = db.Where("to_address IN ?", filters.To)
+	}
+
+	// address
+	if filters.Address != "" {
+		db = db.Where("(from_address = ? OR to_address = ?)", filters.Address, filters.Address)
 	}
 
 	// type
-	if _type != "" {
-		db = db.Where("type = ?", _type)
+	if filters.Type != "" {
+		db = db.Where("type = ?", filters.Type)
 	}
 
 	// block number
-	if blockNumber != 0 {
-		db = db.Where("block_number = ?", blockNumber)
+	if filters.BlockNumber != 0 {
+		db = db.Where("block_number = ?", filters.BlockNumber)
 	}
 
 	// start block number
-	if startBlockNumber != 0 {
-		db = db.Where("block_number >= ?", startBlockNumber)
+	if filters.StartBlockNumber != 0 {
+		db = db.Where("block_number >= ?", filters.StartBlockNumber)
 	}
 
 	// end block number
-	if endBlockNumber != 0 {
-		db = db.Where("block_number <= ?", endBlockNumber)
+	if filters.EndBlockNumber != 0 {
+		db = db.Where("block_number <= ?", filters.EndBlockNumber)
+	}
+
+	// start timestamp
+	if filters.StartTimestamp != 0 {
+		db = db.Where("block_timestamp >= ?", filters.StartTimestamp)
+	}
+
+	// end timestamp
+	if filters.EndTimestamp != 0 {
+		db = db.Where("block_timestamp <= ?", filters.EndTimestamp)
 	}
 
 	// method
-	if method != "" {
-		db = db.Where("method = ?", method)
+	if len(filters.Method) == 1 {
+		db = db.Where("method = ?", filters.Method[0])
+	} else if len(filters.Method) > 1 {
+		db = db.Where("method IN ?", filters.Method)
+	}
+
+	// min value
+	if filters.MinValue != "" {
+		db = db.Where(hexColumnCompare("value", ">="), filters.MinValue, filters.MinValue, filters.MinValue)
 	}
 
+	// max value
+	if filters.MaxValue != "" {
+		db = db.Where(hexColumnCompare("value", "<="), filters.MaxValue, filters.MaxValue, filters.MaxValue)
+	}
+
+	// status
+	switch filters.Status {
+	case "success":
+		db = db.Where("receipt_status = ?", 1)
+	case "failed":
+		db = db.Where("receipt_status = ?", 0)
+	}
+
+	return db
+}
+
+// SelectManyAPI - select from transactions table
+// Returns: models, error (if present)
+func (m *TransactionModel) SelectManyAPI(
+	limit int,
+	skip int,
+	filters *TransactionFilters,
+) (*[]models.TransactionAPIList, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Order
+	db = applyTransactionOrder(db, filters)
+
+	// Filters
+	db = applyTransactionFilters(db, filters)
+
 	// Limit is required and defaulted to 1
 	db = db.Limit(limit)
 
@@ -215,12 +303,32 @@ func (m *TransactionModel) SelectManyAPI(
 	return transactions, db.Error
 }
 
+// CountAPI - count transactions matching filters
+// NOTE counts above maxCount are estimated
+// Returns: count, is estimated, error (if present)
+func (m *TransactionModel) CountAPI(
+	filters *TransactionFilters,
+	maxCount int64,
+) (int64, bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.Transaction{})
+
+	// Filters
+	db = applyTransactionFilters(db, filters)
+
+	return countCapped(db, maxCount)
+}
+
 // SelectManyByAddressAPI - select from transactions table
 // Returns: models, error (if present)
 func (m *TransactionModel) SelectManyByAddressAPI(
 	limit int,
 	skip int,
 	address string,
+	startBlockNumber int,
+	endBlockNumber int,
 ) (*[]models.TransactionAPIList, error) {
 	db := m.db
 
@@ -231,18 +339,21 @@ func (m *TransactionModel) SelectManyByAddressAPI(
 	db = db.Order("block_number DESC")
 
 	// Address
-	db = db.Where(`hash
-	IN (
-		SELECT
-			transaction_hash
-		FROM
-			transaction_count_by_address_indices
-		WHERE
-			address = ?
-		ORDER BY block_number desc
-		LIMIT ?
-		OFFSET ?
-	)`, address, limit, skip)
+	subQuery := m.db.Table("transaction_count_by_address_indices").Select("transaction_hash")
+	subQuery = subQuery.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number <= ?", endBlockNumber)
+	}
+
+	subQuery = subQuery.Order("block_number desc").Limit(limit).Offset(skip)
+	db = db.Where("hash IN (?)", subQuery)
 
 	// Type
 	db = db.Where("type = ?", "transaction")
@@ -296,6 +407,8 @@ func (m *TransactionModel) SelectManyInternalByAddressAPI(
 	limit int,
 	skip int,
 	address string,
+	startBlockNumber int,
+	endBlockNumber int,
 ) (*[]models.TransactionInternalAPIList, error) {
 	db := m.db
 
@@ -305,6 +418,145 @@ func (m *TransactionModel) SelectManyInternalByAddressAPI(
 	// Latest transactions first
 	db = db.Order("transactions.block_number DESC")
 
+	// Address
+	subQuery := m.db.Table("transaction_internal_count_by_address_indices").Select("transaction_hash, log_index")
+	subQuery = subQuery.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		subQuery = subQuery.Where("block_number <= ?", endBlockNumber)
+	}
+
+	subQuery = subQuery.Order("block_number desc").Limit(limit).Offset(skip)
+	db = db.Where("(hash, log_index) IN (?)", subQuery)
+
+	// Type
+	db = db.Where("type = ?", "log")
+
+	transactions := &[]models.TransactionInternalAPIList{}
+	db = db.Find(transactions)
+
+	return transactions, db.Error
+}
+
+// StreamManyAPI - stream from transactions table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TransactionModel) StreamManyAPI(
+	filters *TransactionFilters,
+	options *StreamOptions,
+	fn func(*models.TransactionAPIList) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Order
+	db = applyTransactionOrder(db, filters)
+
+	// Filters
+	db = applyTransactionFilters(db, filters)
+
+	// Columns
+	// NOTE ranges are part of the filters
+	db = applyStreamOptions(db, options, "", "")
+
+	transaction := &models.TransactionAPIList{}
+	return streamRows(db, transaction, func() error {
+		return fn(transaction)
+	})
+}
+
+// StreamManyByAddressAPI - stream from transactions table by address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TransactionModel) StreamManyByAddressAPI(
+	address string,
+	options *StreamOptions,
+	fn func(*models.TransactionAPIList) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Latest transactions first
+	db = db.Order("block_number DESC")
+
+	// Address
+	db = db.Where(`hash
+	IN (
+		SELECT
+			transaction_hash
+		FROM
+			transaction_count_by_address_indices
+		WHERE
+			address = ?
+	)`, address)
+
+	// Type
+	db = db.Where("type = ?", "transaction")
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	transaction := &models.TransactionAPIList{}
+	return streamRows(db, transaction, func() error {
+		return fn(transaction)
+	})
+}
+
+// StreamManyInternalAPI - stream from internal transactions table
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TransactionModel) StreamManyInternalAPI(
+	hash string,
+	options *StreamOptions,
+	fn func(*models.TransactionInternalAPIList) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Latest transactions first
+	db = db.Order("block_number desc")
+
+	// Hash
+	if hash != "" {
+		db = db.Where("hash = ?", hash)
+	}
+
+	// Internal transactions only
+	db = db.Where("type = ?", "log")
+
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "block_number", "block_timestamp")
+
+	transaction := &models.TransactionInternalAPIList{}
+	return streamRows(db, transaction, func() error {
+		return fn(transaction)
+	})
+}
+
+// StreamManyInternalByAddressAPI - stream from internal transactions table by address
+// NOTE fn is called once per row, the row is reused between calls
+func (m *TransactionModel) StreamManyInternalByAddressAPI(
+	address string,
+	options *StreamOptions,
+	fn func(*models.TransactionInternalAPIList) error,
+) error {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Latest transactions first
+	db = db.Order("transactions.block_number DESC")
+
 	// Address
 	db = db.Where(`(hash, log_index)
 	IN (
@@ -314,18 +566,82 @@ func (m *TransactionModel) SelectManyInternalByAddressAPI(
 			transaction_internal_count_by_address_indices
 		WHERE
 			address = ?
-		ORDER BY block_number desc
-		LIMIT ?
-		OFFSET ?
-	)`, address, limit, skip)
+	)`, address)
 
 	// Type
 	db = db.Where("type = ?", "log")
 
-	transactions := &[]models.TransactionInternalAPIList{}
-	db = db.Find(transactions)
+	// Columns and ranges
+	db = applyStreamOptions(db, options, "transactions.block_number", "transactions.block_timestamp")
 
-	return transactions, db.Error
+	transaction := &models.TransactionInternalAPIList{}
+	return streamRows(db, transaction, func() error {
+		return fn(transaction)
+	})
+}
+
+// SelectOneByTimestamp - select the block closest to a timestamp
+// NOTE closest is "before", "after", or "" for the nearest block on either side
+// Returns: model with block_number and block_timestamp, error (if present)
+func (m *TransactionModel) SelectOneByTimestamp(
+	timestamp int64,
+	closest string,
+) (*models.Transaction, error) {
+
+	// Before
+	var before *models.Transaction
+	if closest != "after" {
+		db := m.db
+
+		// Set table
+		db = db.Model(&[]models.Transaction{})
+
+		db = db.Select("block_number, block_timestamp")
+		db = db.Where("block_timestamp <= ?", timestamp)
+		db = db.Order("block_timestamp desc")
+
+		transaction := &models.Transaction{}
+		db = db.First(transaction)
+		if db.Error == nil {
+			before = transaction
+		} else if !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			return nil, db.Error
+		}
+	}
+
+	// After
+	var after *models.Transaction
+	if closest != "before" {
+		db := m.db
+
+		// Set table
+		db = db.Model(&[]models.Transaction{})
+
+		db = db.Select("block_number, block_timestamp")
+		db = db.Where("block_timestamp >= ?", timestamp)
+		db = db.Order("block_timestamp asc")
+
+		transaction := &models.Transaction{}
+		db = db.First(transaction)
+		if db.Error == nil {
+			after = transaction
+		} else if !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			return nil, db.Error
+		}
+	}
+
+	switch {
+	case before == nil && after == nil:
+		return nil, gorm.ErrRecordNotFound
+	case before == nil:
+		return after, nil
+	case after == nil:
+		return before, nil
+	case uint64(timestamp)-before.BlockTimestamp <= after.BlockTimestamp-uint64(timestamp):
+		return before, nil
+	}
+
+	return after, nil
 }
 
 // SelectOne - select from transactions table
@@ -372,6 +688,53 @@ func (m *TransactionModel) SelectOneAPI(
 	return transaction, db.Error
 }
 
+// SelectManyAPIByHashes - select many transactions by hash
+// NOTE same rows as SelectOneAPI with logIndex -1, in one query
+// Returns: models (missing hashes are skipped), error (if present)
+func (m *TransactionModel) SelectManyAPIByHashes(
+	hashes []string,
+) (*[]models.TransactionAPIDetail, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	// Hashes
+	db = db.Where("hash IN ?", hashes)
+
+	// Log Index
+	db = db.Where("log_index = ?", -1)
+
+	transactions := &[]models.TransactionAPIDetail{}
+	db = db.Find(transactions)
+
+	return transactions, db.Error
+}
+
+// SelectManyInternalAPIByHashes - select the internal transactions of many transactions
+// Returns: models, error (if present)
+func (m *TransactionModel) SelectManyInternalAPIByHashes(
+	hashes []string,
+) (*[]models.TransactionInternalAPIList, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.Transaction{})
+
+	db = db.Order("hash, log_index")
+
+	// Hashes
+	db = db.Where("hash IN ?", hashes)
+
+	// Internal transactions only
+	db = db.Where("type = ?", "log")
+
+	transactions := &[]models.TransactionInternalAPIList{}
+	db = db.Find(transactions)
+
+	return transactions, db.Error
+}
+
 // SelectCount - select from blockCounts table
 // NOTE very slow operation
 func (m *TransactionModel) CountRegular() (int64, error) {
@@ -435,12 +798,8 @@ func (m *TransactionModel) UpsertOne(
 	)
 
 	// Upsert
-	db = db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "hash"}, {Name: "log_index"}}, // NOTE set to primary keys for table
-		DoUpdates: clause.Assignments(updateOnConflictValues),
-	}).Create(transaction)
-
-	return db.Error
+	// NOTE primary keys include block_number when partitioned
+	return m.partitions.upsert(db, transaction, updateOnConflictValues)
 }
 
 // StartTransactionLoader starts loader
@@ -448,6 +807,9 @@ func StartTransactionLoader() {
 	go func() {
 		postgresLoaderChan := GetTransactionModel().LoaderChannel
 
+		// Latest block number sent to redis
+		tipBlockNumber := uint64(0)
+
 		for {
 			// Read transaction
 			newTransaction := <-postgresLoaderChan
@@ -494,6 +856,29 @@ func StartTransactionLoader() {
 				zap.S().Fatal(err.Error())
 			}
 
+			///////////
+			// Stats //
+			///////////
+			err = GetTransactionStatModel().ApplyTransaction(newTransaction)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=Transaction, Hash=", newTransaction.Hash, " LogIndex=", newTransaction.LogIndex, " - Error: ", err.Error())
+			}
+
+			/////////
+			// Tip //
+			/////////
+			// NOTE api caches are invalidated by a new tip
+			if newTransaction.BlockNumber > tipBlockNumber {
+				err = redis.GetRedisClient().SetTipBlockNumber(newTransaction.BlockNumber)
+				if err != nil {
+					// Redis error
+					zap.S().Warn("Loader=Transaction, Hash=", newTransaction.Hash, " LogIndex=", newTransaction.LogIndex, " - Error: ", err.Error())
+				} else {
+					tipBlockNumber = newTransaction.BlockNumber
+				}
+			}
+
 			// Reload all tokenTransfers
 			//tokenTransfers, _ := GetTokenTransferModel().SelectMany(
 			//		100,                 // Limit
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
@@ -38,24 +39,12 @@ func GetTransactionCountModel() *TransactionCountModel {
 			LoaderChannel: make(chan *models.TransactionCount, 1),
 		}
 
-		err := transactionCountModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionCountModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionCountLoader()
 	})
 
 	return transactionCountModel
 }
 
-// Migrate - migrate transactionCounts table
-func (m *TransactionCountModel) Migrate() error {
-	// Only using TransactionCountRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from transactionCounts table
 func (m *TransactionCountModel) SelectOne(_type string) (*models.TransactionCount, error) {
 	db := m.db
@@ -125,7 +114,7 @@ func StartTransactionCountLoader() {
 			//////////////////////////
 			// Get count from redis //
 			//////////////////////////
-			countKey := "icon_transactions_transaction_count_" + newTransactionCount.Type
+			countKey := config.Config.RedisKeyPrefix + "transaction_count_" + newTransactionCount.Type
 
 			count, err := redis.GetRedisClient().GetCount(countKey)
 			if err != nil {
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
@@ -38,24 +39,12 @@ func GetTransactionCountByAddressModel() *TransactionCountByAddressModel {
 			LoaderChannel: make(chan *models.TransactionCountByAddress, 1),
 		}
 
-		err := transactionCountByAddressModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionCountByAddressModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionCountByAddressLoader()
 	})
 
 	return transactionCountByAddressModel
 }
 
-// Migrate - migrate transactionCountByAddresss table
-func (m *TransactionCountByAddressModel) Migrate() error {
-	// Only using TransactionCountByAddressRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from transactionCountByAddresss table
 func (m *TransactionCountByAddressModel) SelectOne(address string) (*models.TransactionCountByAddress, error) {
 	db := m.db
@@ -93,6 +82,31 @@ func (m *TransactionCountByAddressModel) SelectMany(limit int, skip int) (*[]mod
 	return transactionCountByAddresses, db.Error
 }
 
+// SelectManyAfterAddress - select from transaction_count_by_addresses table in address order
+// Used by routines resuming from the last address processed
+// Returns: models, error (if present)
+func (m *TransactionCountByAddressModel) SelectManyAfterAddress(limit int, address string) (*[]models.TransactionCountByAddress, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TransactionCountByAddress{})
+
+	// Address
+	if address != "" {
+		db = db.Where("address > ?", address)
+	}
+
+	db = db.Order("address")
+
+	// Limit
+	db = db.Limit(limit)
+
+	transactionCountByAddresses := &[]models.TransactionCountByAddress{}
+	db = db.Find(transactionCountByAddresses)
+
+	return transactionCountByAddresses, db.Error
+}
+
 // Select - select from transactionCountByAddresss table
 func (m *TransactionCountByAddressModel) SelectCount(address string) (uint64, error) {
 	db := m.db
@@ -114,6 +128,23 @@ func (m *TransactionCountByAddressModel) SelectCount(address string) (uint64, er
 	return count, db.Error
 }
 
+// SelectManyByAddresses - select counts of many addresses from transaction_count_by_addresses table
+// NOTE addresses without a count are omitted
+func (m *TransactionCountByAddressModel) SelectManyByAddresses(addresses []string) (*[]models.TransactionCountByAddress, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TransactionCountByAddress{})
+
+	// Addresses
+	db = db.Where("address IN ?", addresses)
+
+	transactionCountByAddresses := &[]models.TransactionCountByAddress{}
+	db = db.Find(transactionCountByAddresses)
+
+	return transactionCountByAddresses, db.Error
+}
+
 func (m *TransactionCountByAddressModel) UpsertOne(
 	transactionCountByAddress *models.TransactionCountByAddress,
 ) error {
@@ -146,7 +177,7 @@ func StartTransactionCountByAddressLoader() {
 			//////////////////////////
 			// Get count from redis //
 			//////////////////////////
-			countKey := "icon_transactions_transaction_count_by_address_" + newTransactionCountByAddress.Address
+			countKey := config.Config.RedisKeyPrefix + "transaction_count_by_address_" + newTransactionCountByAddress.Address
 
 			count, err := redis.GetRedisClient().GetCount(countKey)
 			if err != nil {
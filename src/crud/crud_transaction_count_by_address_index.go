@@ -6,7 +6,6 @@ import (
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 
 	"github.com/geometry-labs/icon-transactions/models"
 )
@@ -17,6 +16,7 @@ type TransactionCountByAddressIndexModel struct {
 	model         *models.TransactionCountByAddressIndex
 	modelORM      *models.TransactionCountByAddressIndexORM
 	LoaderChannel chan *models.TransactionCountByAddressIndex
+	partitions    *partitionedTable
 }
 
 var transactionCountByAddressIndexModel *TransactionCountByAddressIndexModel
@@ -34,24 +34,19 @@ func GetTransactionCountByAddressIndexModel() *TransactionCountByAddressIndexMod
 			db:            dbConn,
 			model:         &models.TransactionCountByAddressIndex{},
 			LoaderChannel: make(chan *models.TransactionCountByAddressIndex, 1),
+			partitions:    newPartitionedTable(dbConn, "transaction_count_by_address_indices", "transaction_hash", "address"),
 		}
 
-		err := transactionCountByAddressIndexModel.Migrate()
+		// NOTE tables are migrated by the migrate command
+		err := transactionCountByAddressIndexModel.partitions.refresh()
 		if err != nil {
-			zap.S().Fatal("TransactionCountByAddressIndexModel: Unable migrate postgres table: ", err.Error())
+			zap.S().Fatal("TransactionCountByAddressIndexModel: Unable to read postgres table partitions: ", err.Error())
 		}
 	})
 
 	return transactionCountByAddressIndexModel
 }
 
-// Migrate - migrate transactionCountByAddressIndexs table
-func (m *TransactionCountByAddressIndexModel) Migrate() error {
-	// Only using TransactionCountByAddressIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 func (m *TransactionCountByAddressIndexModel) SelectMissingBlockNumbers(
 	limit int,
 ) (*[]models.TransactionCountByAddressIndex, error) {
@@ -89,6 +84,36 @@ func (m *TransactionCountByAddressIndexModel) CountByAddress(address string) (in
 	return count, db.Error
 }
 
+// CountByAddressBlockRange - Count by address in a block range
+// NOTE counts above maxCount are estimated
+// Returns: count, is estimated, error (if present)
+func (m *TransactionCountByAddressIndexModel) CountByAddressBlockRange(
+	address string,
+	startBlockNumber int,
+	endBlockNumber int,
+	maxCount int64,
+) (int64, bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TransactionCountByAddressIndex{})
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		db = db.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		db = db.Where("block_number <= ?", endBlockNumber)
+	}
+
+	return countCapped(db, maxCount)
+}
+
 // Insert - Insert transactionCountByIndex into table
 func (m *TransactionCountByAddressIndexModel) Insert(transactionCountByAddressIndex *models.TransactionCountByAddressIndex) error {
 	db := m.db
@@ -113,10 +138,6 @@ func (m *TransactionCountByAddressIndexModel) UpsertOne(
 	)
 
 	// Upsert
-	db = db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "address"}}, // NOTE set to primary keys for table
-		DoUpdates: clause.Assignments(updateOnConflictValues),
-	}).Create(transactionCountByAddressIndex)
-
-	return db.Error
+	// NOTE primary keys include block_number when partitioned
+	return m.partitions.upsert(db, transactionCountByAddressIndex, updateOnConflictValues)
 }
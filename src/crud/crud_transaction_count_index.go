@@ -33,23 +33,11 @@ func GetTransactionCountIndexModel() *TransactionCountIndexModel {
 			model:         &models.TransactionCountIndex{},
 			LoaderChannel: make(chan *models.TransactionCountIndex, 1),
 		}
-
-		err := transactionCountIndexModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionCountIndexModel: Unable migrate postgres table: ", err.Error())
-		}
 	})
 
 	return transactionCountIndexModel
 }
 
-// Migrate - migrate transactionCountIndexs table
-func (m *TransactionCountIndexModel) Migrate() error {
-	// Only using TransactionCountIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Count - count all entries in transaction_count_indices table
 // NOTE this function will take a long time
 func (m *TransactionCountIndexModel) Count() (int64, error) {
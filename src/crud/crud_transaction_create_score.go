@@ -36,24 +36,12 @@ func GetTransactionCreateScoreModel() *TransactionCreateScoreModel {
 			LoaderChannel: make(chan *models.TransactionCreateScore, 1),
 		}
 
-		err := transactionCreateScoreModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionCreateScoreModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionCreateScoreLoader()
 	})
 
 	return transactionCreateScoreModel
 }
 
-// Migrate - migrate transactionCreateScores table
-func (m *TransactionCreateScoreModel) Migrate() error {
-	// Only using TransactionCreateScoreRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from transactionCreateScores table
 func (m *TransactionCreateScoreModel) SelectOne(creationTransactionHash string) (*models.TransactionCreateScore, error) {
 	db := m.db
@@ -70,6 +58,27 @@ func (m *TransactionCreateScoreModel) SelectOne(creationTransactionHash string)
 	return transactionCreateScore, db.Error
 }
 
+// SelectOneByTransactionHash - select the row a creation, accept, or reject transaction belongs to
+func (m *TransactionCreateScoreModel) SelectOneByTransactionHash(transactionHash string) (*models.TransactionCreateScore, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TransactionCreateScore{})
+
+	// Transaction Hash
+	db = db.Where(
+		"creation_transaction_hash = ? OR accept_transaction_hash = ? OR reject_transaction_hash = ?",
+		transactionHash,
+		transactionHash,
+		transactionHash,
+	)
+
+	transactionCreateScore := &models.TransactionCreateScore{}
+	db = db.First(transactionCreateScore)
+
+	return transactionCreateScore, db.Error
+}
+
 func (m *TransactionCreateScoreModel) UpsertOne(
 	transactionCreateScore *models.TransactionCreateScore,
 ) error {
@@ -112,6 +121,13 @@ func StartTransactionCreateScoreLoader() {
 
 			// Reload transaction
 			reloadTransaction(newTransactionCreateScore.CreationTransactionHash)
+
+			// Reload contract
+			err = reloadContractUpdate(newTransactionCreateScore.CreationTransactionHash)
+			if err != nil {
+				// Postgres error
+				zap.S().Fatal("Loader=TransactionCreateScore, CreationTransactionHash=", newTransactionCreateScore.CreationTransactionHash, " - Error: ", err.Error())
+			}
 		}
 	}()
 }
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
 )
@@ -38,24 +39,12 @@ func GetTransactionInternalCountByAddressModel() *TransactionInternalCountByAddr
 			LoaderChannel: make(chan *models.TransactionInternalCountByAddress, 1),
 		}
 
-		err := transactionInternalCountByAddressModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionInternalCountByAddressModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionInternalCountByAddressLoader()
 	})
 
 	return transactionInternalCountByAddressModel
 }
 
-// Migrate - migrate transactionInternalCountByAddresss table
-func (m *TransactionInternalCountByAddressModel) Migrate() error {
-	// Only using TransactionInternalCountByAddressRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Select - select from transactionInternalCountByAddresss table
 func (m *TransactionInternalCountByAddressModel) SelectOne(address string) (*models.TransactionInternalCountByAddress, error) {
 	db := m.db
@@ -93,6 +82,31 @@ func (m *TransactionInternalCountByAddressModel) SelectMany(limit int, skip int)
 	return transactionInternalCountByAddresses, db.Error
 }
 
+// SelectManyAfterAddress - select from transaction_internal_count_by_addresses table in address order
+// Used by routines resuming from the last address processed
+// Returns: models, error (if present)
+func (m *TransactionInternalCountByAddressModel) SelectManyAfterAddress(limit int, address string) (*[]models.TransactionInternalCountByAddress, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TransactionInternalCountByAddress{})
+
+	// Address
+	if address != "" {
+		db = db.Where("address > ?", address)
+	}
+
+	db = db.Order("address")
+
+	// Limit
+	db = db.Limit(limit)
+
+	transactionInternalCountByAddresses := &[]models.TransactionInternalCountByAddress{}
+	db = db.Find(transactionInternalCountByAddresses)
+
+	return transactionInternalCountByAddresses, db.Error
+}
+
 // Select - select from transactionInternalCountByAddresss table
 func (m *TransactionInternalCountByAddressModel) SelectCount(address string) (uint64, error) {
 	db := m.db
@@ -146,7 +160,7 @@ func StartTransactionInternalCountByAddressLoader() {
 			//////////////////////////
 			// Get count from redis //
 			//////////////////////////
-			countKey := "icon_transactions_transaction_internal_count_by_address_" + newTransactionInternalCountByAddress.Address
+			countKey := config.Config.RedisKeyPrefix + "transaction_internal_count_by_address_" + newTransactionInternalCountByAddress.Address
 
 			count, err := redis.GetRedisClient().GetCount(countKey)
 			if err != nil {
@@ -6,7 +6,6 @@ import (
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 
 	"github.com/geometry-labs/icon-transactions/models"
 )
@@ -17,6 +16,7 @@ type TransactionInternalCountByAddressIndexModel struct {
 	model         *models.TransactionInternalCountByAddressIndex
 	modelORM      *models.TransactionInternalCountByAddressIndexORM
 	LoaderChannel chan *models.TransactionInternalCountByAddressIndex
+	partitions    *partitionedTable
 }
 
 var transactionInternalCountByAddressIndexModel *TransactionInternalCountByAddressIndexModel
@@ -34,24 +34,19 @@ func GetTransactionInternalCountByAddressIndexModel() *TransactionInternalCountB
 			db:            dbConn,
 			model:         &models.TransactionInternalCountByAddressIndex{},
 			LoaderChannel: make(chan *models.TransactionInternalCountByAddressIndex, 1),
+			partitions:    newPartitionedTable(dbConn, "transaction_internal_count_by_address_indices", "transaction_hash", "log_index", "address"),
 		}
 
-		err := transactionInternalCountByAddressIndexModel.Migrate()
+		// NOTE tables are migrated by the migrate command
+		err := transactionInternalCountByAddressIndexModel.partitions.refresh()
 		if err != nil {
-			zap.S().Fatal("TransactionInternalCountByAddressIndexModel: Unable migrate postgres table: ", err.Error())
+			zap.S().Fatal("TransactionInternalCountByAddressIndexModel: Unable to read postgres table partitions: ", err.Error())
 		}
 	})
 
 	return transactionInternalCountByAddressIndexModel
 }
 
-// Migrate - migrate transactionInternalCountByAddressIndexs table
-func (m *TransactionInternalCountByAddressIndexModel) Migrate() error {
-	// Only using TransactionInternalCountByAddressIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 func (m *TransactionInternalCountByAddressIndexModel) SelectMissingBlockNumbers(
 	limit int,
 ) (*[]models.TransactionInternalCountByAddressIndex, error) {
@@ -89,6 +84,36 @@ func (m *TransactionInternalCountByAddressIndexModel) CountByAddress(address str
 	return count, db.Error
 }
 
+// CountByAddressBlockRange - Count by address in a block range
+// NOTE counts above maxCount are estimated
+// Returns: count, is estimated, error (if present)
+func (m *TransactionInternalCountByAddressIndexModel) CountByAddressBlockRange(
+	address string,
+	startBlockNumber int,
+	endBlockNumber int,
+	maxCount int64,
+) (int64, bool, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TransactionInternalCountByAddressIndex{})
+
+	// Address
+	db = db.Where("address = ?", address)
+
+	// start block number
+	if startBlockNumber != 0 {
+		db = db.Where("block_number >= ?", startBlockNumber)
+	}
+
+	// end block number
+	if endBlockNumber != 0 {
+		db = db.Where("block_number <= ?", endBlockNumber)
+	}
+
+	return countCapped(db, maxCount)
+}
+
 // Insert - Insert transactionCountByIndex into table
 func (m *TransactionInternalCountByAddressIndexModel) Insert(transactionInternalCountByAddressIndex *models.TransactionInternalCountByAddressIndex) error {
 	db := m.db
@@ -113,10 +138,6 @@ func (m *TransactionInternalCountByAddressIndexModel) UpsertOne(
 	)
 
 	// Upsert
-	db = db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}, {Name: "address"}}, // NOTE set to primary keys for table
-		DoUpdates: clause.Assignments(updateOnConflictValues),
-	}).Create(transactionInternalCountByAddressIndex)
-
-	return db.Error
+	// NOTE primary keys include block_number when partitioned
+	return m.partitions.upsert(db, transactionInternalCountByAddressIndex, updateOnConflictValues)
 }
@@ -33,23 +33,11 @@ func GetTransactionInternalCountIndexModel() *TransactionInternalCountIndexModel
 			model:         &models.TransactionInternalCountIndex{},
 			LoaderChannel: make(chan *models.TransactionInternalCountIndex, 1),
 		}
-
-		err := transactionInternalCountIndexModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionInternalCountIndexModel: Unable migrate postgres table: ", err.Error())
-		}
 	})
 
 	return transactionInternalCountIndexModel
 }
 
-// Migrate - migrate transactionInternalCountIndexs table
-func (m *TransactionInternalCountIndexModel) Migrate() error {
-	// Only using TransactionInternalCountIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Count - count all entries in transaction_count_indices table
 // NOTE this function will take a long time
 func (m *TransactionInternalCountIndexModel) Count() (int64, error) {
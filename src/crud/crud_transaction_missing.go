@@ -37,24 +37,12 @@ func GetTransactionMissingModel() *TransactionMissingModel {
 			LoaderChannel: make(chan *models.TransactionMissing, 1),
 		}
 
-		err := transactionMissingModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionMissingModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionMissingLoader()
 	})
 
 	return transactionMissingModel
 }
 
-// Migrate - migrate transactionMissings table
-func (m *TransactionMissingModel) Migrate() error {
-	// Only using TransactionMissingRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 func (m *TransactionMissingModel) UpsertOne(
 	transactionMissing *models.TransactionMissing,
 ) error {
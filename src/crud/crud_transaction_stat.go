@@ -0,0 +1,290 @@
+package crud
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+// Periods of transaction stats
+// NOTE buckets are always updated in this order, concurrent loaders would deadlock otherwise
+var transactionStatPeriods = []string{"daily", "hourly"}
+
+// Bucket sizes of transaction stats in micro seconds by period
+var transactionStatBucketSizes = map[string]uint64{
+	"daily":  24 * 3600 * 1000000,
+	"hourly": 3600 * 1000000,
+}
+
+// TransactionStatModel - type for transactionStat table model
+type TransactionStatModel struct {
+	db              *gorm.DB
+	model           *models.TransactionStat
+	modelORM        *models.TransactionStatORM
+	modelIndexORM   *models.TransactionStatIndexORM
+	modelAddressORM *models.TransactionStatAddressORM
+}
+
+var transactionStatModel *TransactionStatModel
+var transactionStatModelOnce sync.Once
+
+// GetTransactionStatModel - create and/or return the transactionStats table model
+func GetTransactionStatModel() *TransactionStatModel {
+	transactionStatModelOnce.Do(func() {
+		dbConn := getPostgresConn()
+		if dbConn == nil {
+			zap.S().Fatal("Cannot connect to postgres database")
+		}
+
+		transactionStatModel = &TransactionStatModel{
+			db:    dbConn,
+			model: &models.TransactionStat{},
+		}
+	})
+
+	return transactionStatModel
+}
+
+// SelectMany - select from transaction_stats table
+// Returns: models, error (if present)
+func (m *TransactionStatModel) SelectMany(
+	period string,
+	limit int,
+	skip int,
+	startTimestamp int64,
+	endTimestamp int64,
+) (*[]models.TransactionStat, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&[]models.TransactionStat{})
+
+	// Latest buckets first
+	db = db.Order("bucket_timestamp desc")
+
+	// Period
+	db = db.Where("period = ?", period)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	// Limit is required and defaulted to 1
+	db = db.Limit(limit)
+
+	// Skip
+	if skip != 0 {
+		db = db.Offset(skip)
+	}
+
+	transactionStats := &[]models.TransactionStat{}
+	db = db.Find(transactionStats)
+
+	return transactionStats, db.Error
+}
+
+// CountMany - count from transaction_stats table
+func (m *TransactionStatModel) CountMany(
+	period string,
+	startTimestamp int64,
+	endTimestamp int64,
+) (int64, error) {
+	db := m.db
+
+	// Set table
+	db = db.Model(&models.TransactionStat{})
+
+	// Period
+	db = db.Where("period = ?", period)
+
+	// start timestamp
+	if startTimestamp != 0 {
+		db = db.Where("bucket_timestamp >= ?", startTimestamp)
+	}
+
+	// end timestamp
+	if endTimestamp != 0 {
+		db = db.Where("bucket_timestamp <= ?", endTimestamp)
+	}
+
+	count := int64(0)
+	db = db.Count(&count)
+
+	return count, db.Error
+}
+
+// ApplyTransaction - add a transaction or internal transaction to its buckets
+// NOTE transactions already added are skipped
+func (m *TransactionStatModel) ApplyTransaction(
+	transaction *models.Transaction,
+) error {
+
+	if transaction.BlockTimestamp == 0 {
+		// Empty reloaded transaction
+		return nil
+	}
+
+	delta := &models.TransactionStat{}
+
+	// NOTE failed transactions transfer no value
+	if transaction.ReceiptStatus != 0 {
+		delta.ValueTotal = transaction.ValueDecimal
+	}
+
+	var onApply func(tx *gorm.DB) error
+	if transaction.Type == "transaction" {
+		delta.TransactionCount = 1
+		delta.StepPriceTotal = transaction.ReceiptStepPrice
+		delta.AverageStepPrice = float64(transaction.ReceiptStepPrice)
+		delta.StepUsedTotal = transaction.ReceiptStepUsed
+
+		if transaction.TransactionFee != "" {
+			fee, err := hexToBigInt(transaction.TransactionFee)
+			if err != nil {
+				return err
+			}
+
+			delta.FeeTotal = bigIntToFloat64(fee, 18)
+		}
+
+		onApply = func(tx *gorm.DB) error {
+			return GetFeeStatModel().applyTransaction(tx, transaction, delta.FeeTotal)
+		}
+	} else {
+		delta.InternalTransactionCount = 1
+	}
+
+	return m.apply(
+		&models.TransactionStatIndex{
+			TransactionHash: transaction.Hash,
+			LogIndex:        transaction.LogIndex,
+			Type:            transaction.Type,
+		},
+		transaction.BlockTimestamp,
+		delta,
+		[]string{transaction.FromAddress, transaction.ToAddress},
+		onApply,
+	)
+}
+
+// ApplyTokenTransfer - add a token transfer to its buckets
+// NOTE token transfers already added are skipped
+func (m *TransactionStatModel) ApplyTokenTransfer(
+	tokenTransfer *models.TokenTransfer,
+) error {
+
+	if tokenTransfer.BlockTimestamp == 0 {
+		// Empty reloaded transfer
+		return nil
+	}
+
+	return m.apply(
+		&models.TransactionStatIndex{
+			TransactionHash: tokenTransfer.TransactionHash,
+			LogIndex:        tokenTransfer.LogIndex,
+			Type:            "token_transfer",
+		},
+		tokenTransfer.BlockTimestamp,
+		&models.TransactionStat{
+			TokenTransferCount: 1,
+		},
+		nil,
+		func(tx *gorm.DB) error {
+			return GetTokenContractStatModel().applyTokenTransfer(tx, tokenTransfer)
+		},
+	)
+}
+
+// apply - add delta to the buckets of blockTimestamp in one transaction
+// NOTE onApply is called in the same transaction for rollups of other tables
+func (m *TransactionStatModel) apply(
+	transactionStatIndex *models.TransactionStatIndex,
+	blockTimestamp uint64,
+	delta *models.TransactionStat,
+	addresses []string,
+	onApply func(tx *gorm.DB) error,
+) error {
+
+	return m.db.Transaction(func(tx *gorm.DB) error {
+
+		// Add to indexed
+		db := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transactionStatIndex)
+		if db.Error != nil {
+			return db.Error
+		}
+		if db.RowsAffected == 0 {
+			// Already counted
+			return nil
+		}
+
+		for _, period := range transactionStatPeriods {
+			bucketSize := transactionStatBucketSizes[period]
+
+			transactionStat := &models.TransactionStat{
+				Period:                   period,
+				BucketTimestamp:          blockTimestamp - (blockTimestamp % bucketSize),
+				TransactionCount:         delta.TransactionCount,
+				InternalTransactionCount: delta.InternalTransactionCount,
+				TokenTransferCount:       delta.TokenTransferCount,
+				ValueTotal:               delta.ValueTotal,
+				FeeTotal:                 delta.FeeTotal,
+				StepPriceTotal:           delta.StepPriceTotal,
+				AverageStepPrice:         delta.AverageStepPrice,
+				StepUsedTotal:            delta.StepUsedTotal,
+			}
+
+			// Active addresses
+			for _, address := range addresses {
+				if address == "" || address == "None" {
+					continue
+				}
+
+				db = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.TransactionStatAddress{
+					Period:          transactionStat.Period,
+					BucketTimestamp: transactionStat.BucketTimestamp,
+					Address:         address,
+				})
+				if db.Error != nil {
+					return db.Error
+				}
+
+				transactionStat.ActiveAddressCount += uint64(db.RowsAffected)
+			}
+
+			// Increment
+			db = tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "period"}, {Name: "bucket_timestamp"}}, // NOTE set to primary keys for table
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"transaction_count":          gorm.Expr("transaction_stats.transaction_count + EXCLUDED.transaction_count"),
+					"internal_transaction_count": gorm.Expr("transaction_stats.internal_transaction_count + EXCLUDED.internal_transaction_count"),
+					"token_transfer_count":       gorm.Expr("transaction_stats.token_transfer_count + EXCLUDED.token_transfer_count"),
+					"active_address_count":       gorm.Expr("transaction_stats.active_address_count + EXCLUDED.active_address_count"),
+					"value_total":                gorm.Expr("transaction_stats.value_total + EXCLUDED.value_total"),
+					"fee_total":                  gorm.Expr("transaction_stats.fee_total + EXCLUDED.fee_total"),
+					"step_price_total":           gorm.Expr("transaction_stats.step_price_total + EXCLUDED.step_price_total"),
+					"step_used_total":            gorm.Expr("transaction_stats.step_used_total + EXCLUDED.step_used_total"),
+					"average_step_price":         gorm.Expr("(transaction_stats.step_price_total + EXCLUDED.step_price_total)::float / GREATEST(transaction_stats.transaction_count + EXCLUDED.transaction_count, 1)"),
+				}),
+			}).Create(transactionStat)
+			if db.Error != nil {
+				return db.Error
+			}
+		}
+
+		if onApply != nil {
+			return onApply(tx)
+		}
+
+		return nil
+	})
+}
@@ -97,7 +97,7 @@ func TestTransactionModelSelectOne(t *testing.T) {
 	transactionModel := GetTransactionModel()
 	assert.NotEqual(nil, transactionModel)
 
-	migrateErr := transactionModel.Migrate()
+	migrateErr := MigrateUp(0)
 	assert.Equal(nil, migrateErr)
 
 	// Load fixtures
@@ -33,23 +33,11 @@ func GetTransactionTokenTransferCountIndexModel() *TransactionTokenTransferCount
 			model:         &models.TransactionTokenTransferCountIndex{},
 			LoaderChannel: make(chan *models.TransactionTokenTransferCountIndex, 1),
 		}
-
-		err := transactionTokenTransaferCountIndexModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionTokenTransferCountIndexModel: Unable migrate postgres table: ", err.Error())
-		}
 	})
 
 	return transactionTokenTransaferCountIndexModel
 }
 
-// Migrate - migrate transactionTokenTransaferCountIndexs table
-func (m *TransactionTokenTransferCountIndexModel) Migrate() error {
-	// Only using TransactionTokenTransferCountIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Count - count all entries in transaction_count_indices table
 // NOTE this function will take a long time
 func (m *TransactionTokenTransferCountIndexModel) Count() (int64, error) {
@@ -37,24 +37,12 @@ func GetTransactionWebsocketIndexModel() *TransactionWebsocketIndexModel {
 			LoaderChannel: make(chan *models.TransactionWebsocket, 1),
 		}
 
-		err := transactionWebsocketIndexModel.Migrate()
-		if err != nil {
-			zap.S().Fatal("TransactionWebsocketIndexModel: Unable migrate postgres table: ", err.Error())
-		}
-
 		StartTransactionWebsocketIndexLoader()
 	})
 
 	return transactionWebsocketIndexModel
 }
 
-// Migrate - migrate transactionWebsocketIndexs table
-func (m *TransactionWebsocketIndexModel) Migrate() error {
-	// Only using TransactionWebsocketIndexRawORM (ORM version of the proto generated struct) to create the TABLE
-	err := m.db.AutoMigrate(m.modelORM) // Migration and Index creation
-	return err
-}
-
 // Insert - Insert transactionWebsocketIndex into table
 func (m *TransactionWebsocketIndexModel) Insert(transactionWebsocketIndex *models.TransactionWebsocketIndex) error {
 	db := m.db
@@ -0,0 +1,405 @@
+package crud
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// NOTE files are named <version>_<name>.up.sql and <version>_<name>.down.sql
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+var migrationFileRegex = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// NOTE statements of files starting with this line are not run in a transaction, ex CREATE INDEX CONCURRENTLY
+const migrationNoTransaction = "-- +migrate no-transaction"
+
+const migrationsTable = "schema_migrations"
+
+// migration - versioned change of the schema
+type migration struct {
+	version           int64
+	name              string
+	up                func(db *gorm.DB) error
+	down              func(db *gorm.DB) error
+	noTransactionUp   bool
+	noTransactionDown bool
+}
+
+// MigrationState - migration and if it is applied
+type MigrationState struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+// getMigrations - migrations in version order
+// NOTE version 1 is the schema of the models before versioned migrations, frozen in 000001_baseline.up.sql
+func getMigrations() ([]*migration, error) {
+	migrations, err := readSQLMigrations(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, errors.New("Duplicate migration version " + strconv.FormatInt(migrations[i].version, 10))
+		}
+	}
+
+	// Baseline
+	if len(migrations) == 0 || migrations[0].version != 1 {
+		return nil, errors.New("Missing baseline migration")
+	}
+	baseline := migrations[0]
+	createTables := baseline.up
+	baseline.up = func(db *gorm.DB) error {
+		err := createTables(db)
+		if err != nil {
+			return err
+		}
+
+		return migratePartitions()
+	}
+	baseline.down = func(db *gorm.DB) error { return errors.New("baseline migration cannot be reverted") }
+	// NOTE partitions are created on their own connection, see partitionedTable.migrate
+	baseline.noTransactionUp = true
+
+	return migrations, nil
+}
+
+// readSQLMigrations - migrations of the sql files of dir
+func readSQLMigrations(fsys fs.FS, dir string) ([]*migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationsByVersion := map[int64]*migration{}
+	for _, entry := range entries {
+		match := migrationFileRegex.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, errors.New("Invalid migration file name " + entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		statements := string(file)
+
+		m, ok := migrationsByVersion[version]
+		if ok == false {
+			m = &migration{
+				version: version,
+				name:    match[2],
+			}
+			migrationsByVersion[version] = m
+		}
+
+		run := func(db *gorm.DB) error {
+			return db.Exec(statements).Error
+		}
+
+		noTransaction := strings.HasPrefix(statements, migrationNoTransaction)
+		if match[3] == "up" {
+			m.up = run
+			m.noTransactionUp = noTransaction
+		} else {
+			m.down = run
+			m.noTransactionDown = noTransaction
+		}
+	}
+
+	migrations := []*migration{}
+	for _, m := range migrationsByVersion {
+		if m.up == nil {
+			return nil, errors.New("Missing up migration for version " + strconv.FormatInt(m.version, 10))
+		}
+
+		migrations = append(migrations, m)
+	}
+
+	return migrations, nil
+}
+
+// migratePartitions - partition the empty tables partitioned by block number, when DB_PARTITION_SIZE is set
+func migratePartitions() error {
+	for _, p := range partitionedTables() {
+		err := p.migrate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createMigrationsTable(db *gorm.DB) error {
+	return db.Exec(
+		"CREATE TABLE IF NOT EXISTS " + migrationsTable + " (" +
+			"version BIGINT PRIMARY KEY, " +
+			"name TEXT NOT NULL, " +
+			"applied_at TIMESTAMPTZ NOT NULL DEFAULT now())",
+	).Error
+}
+
+// getAppliedMigrations - versions in the migrations table
+func getAppliedMigrations(db *gorm.DB) (map[int64]bool, error) {
+	exists := false
+	err := db.Raw("SELECT to_regclass(?) IS NOT NULL", migrationsTable).Row().Scan(&exists)
+	if err != nil || exists == false {
+		return map[int64]bool{}, err
+	}
+
+	versions := []int64{}
+	err = db.Raw("SELECT version FROM " + migrationsTable).Scan(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[int64]bool{}
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	return applied, nil
+}
+
+// GetMigrationStates - migrations and if they are applied, in version order
+func GetMigrationStates() ([]MigrationState, error) {
+	migrations, err := getMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := getAppliedMigrations(usePrimary(getPostgresConn()))
+	if err != nil {
+		return nil, err
+	}
+
+	states := []MigrationState{}
+	for _, m := range migrations {
+		states = append(states, MigrationState{
+			Version: m.version,
+			Name:    m.name,
+			Applied: applied[m.version],
+		})
+	}
+
+	return states, nil
+}
+
+// MigrateUp - apply pending migrations, all if steps is 0
+// NOTE runs of several processes are serialised by an advisory lock
+func MigrateUp(steps int) error {
+	migrations, err := getMigrations()
+	if err != nil {
+		return err
+	}
+
+	db := usePrimary(getPostgresConn())
+
+	unlock, _, err := advisoryLock(db, migrationsTable, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = createMigrationsTable(db)
+	if err != nil {
+		return err
+	}
+
+	applied, err := getAppliedMigrations(db)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		if steps > 0 && count == steps {
+			break
+		}
+
+		zap.S().Info("Migrations: applying ", m.version, "_", m.name)
+		err = runMigration(db, m.noTransactionUp, m.up, func(tx *gorm.DB) error {
+			return tx.Exec("INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?)", m.version, m.name).Error
+		})
+		if err != nil {
+			return errors.New("Migration " + strconv.FormatInt(m.version, 10) + "_" + m.name + " failed: " + err.Error())
+		}
+
+		count++
+	}
+
+	zap.S().Info("Migrations: applied ", count, " migrations")
+	return nil
+}
+
+// MigrateDown - revert the last applied migrations, 1 if steps is 0
+func MigrateDown(steps int) error {
+	if steps == 0 {
+		steps = 1
+	}
+
+	migrations, err := getMigrations()
+	if err != nil {
+		return err
+	}
+
+	db := usePrimary(getPostgresConn())
+
+	unlock, _, err := advisoryLock(db, migrationsTable, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	applied, err := getAppliedMigrations(db)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for i := len(migrations) - 1; i >= 0 && count < steps; i-- {
+		m := migrations[i]
+		if applied[m.version] == false {
+			continue
+		}
+
+		if m.down == nil {
+			return errors.New("Migration " + strconv.FormatInt(m.version, 10) + "_" + m.name + " has no down migration")
+		}
+
+		zap.S().Info("Migrations: reverting ", m.version, "_", m.name)
+		err = runMigration(db, m.noTransactionDown, m.down, func(tx *gorm.DB) error {
+			return tx.Exec("DELETE FROM "+migrationsTable+" WHERE version = ?", m.version).Error
+		})
+		if err != nil {
+			return errors.New("Migration " + strconv.FormatInt(m.version, 10) + "_" + m.name + " failed: " + err.Error())
+		}
+
+		count++
+	}
+
+	zap.S().Info("Migrations: reverted ", count, " migrations")
+	return nil
+}
+
+// runMigration - run a migration then record it, in a transaction unless noTransaction is set
+func runMigration(db *gorm.DB, noTransaction bool, run func(db *gorm.DB) error, record func(db *gorm.DB) error) error {
+	if noTransaction {
+		err := run(db)
+		if err != nil {
+			return err
+		}
+
+		return record(db)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := run(tx)
+		if err != nil {
+			return err
+		}
+
+		return record(tx)
+	})
+}
+
+// CheckMigrations - error if migrations are pending
+// NOTE pending migrations are applied first if DB_MIGRATE_ON_START is set
+func CheckMigrations() error {
+	if config.Config.DbMigrateOnStart {
+		err := MigrateUp(0)
+		if err != nil {
+			return err
+		}
+	}
+
+	states, err := GetMigrationStates()
+	if err != nil {
+		return err
+	}
+
+	pending := []string{}
+	for _, state := range states {
+		if state.Applied == false {
+			pending = append(pending, strconv.FormatInt(state.Version, 10)+"_"+state.Name)
+		}
+	}
+
+	if len(pending) > 0 {
+		return errors.New("Schema is not migrated, run the migrate command. Pending migrations: " + strings.Join(pending, ", "))
+	}
+
+	return nil
+}
+
+// RunMigrateCommand - migrate command, ex `main migrate up`
+// Usage: migrate [up [steps] | down [steps] | status]
+func RunMigrateCommand(args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	steps := 0
+	if len(args) > 1 {
+		var err error
+		steps, err = strconv.Atoi(args[1])
+		if err != nil || steps < 0 {
+			return errors.New("Invalid steps " + args[1])
+		}
+	}
+
+	switch command {
+	case "up":
+		return MigrateUp(steps)
+	case "down":
+		return MigrateDown(steps)
+	case "status":
+		states, err := GetMigrationStates()
+		if err != nil {
+			return err
+		}
+
+		for _, state := range states {
+			status := "pending"
+			if state.Applied {
+				status = "applied"
+			}
+
+			zap.S().Info("Migrations: ", state.Version, "_", state.Name, " ", status)
+		}
+
+		return nil
+	}
+
+	return errors.New("Unknown migrate command " + command + ", use up, down or status")
+}
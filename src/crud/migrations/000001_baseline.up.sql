@@ -0,0 +1,104 @@
+-- Schema of the models before versioned migrations, frozen from the GORM models
+-- NOTE tables and indexes exist on databases created before versioned migrations, they are left unchanged
+-- Partitioned tables are partitioned by the baseline migration when DB_PARTITION_SIZE is set, see migratePartitions
+
+CREATE TABLE IF NOT EXISTS "api_keys" ("created_timestamp" bigint,"is_disabled" boolean,"key_hash" text,"name" text,"tier" text,PRIMARY KEY ("key_hash"));
+CREATE INDEX IF NOT EXISTS "api_key_idx_tier" ON "api_keys" ("tier");
+CREATE TABLE IF NOT EXISTS "api_tiers" ("max_export_size" bigint,"max_page_size" bigint,"name" text,"rate_limit" decimal,"rate_limit_burst" bigint,PRIMARY KEY ("name"));
+
+CREATE TABLE IF NOT EXISTS "contracts" ("accept_transaction_hash" text,"address" text,"created_block_number" bigint,"created_timestamp" bigint,"creation_transaction_hash" text,"deployer_address" text,"reject_transaction_hash" text,"status" text,"updated_block_number" bigint,"updated_timestamp" bigint,"updated_transaction_hash" text,PRIMARY KEY ("address"));
+CREATE INDEX IF NOT EXISTS "contract_idx_created_block_number" ON "contracts" ("created_block_number");
+CREATE INDEX IF NOT EXISTS "contract_idx_deployer_address" ON "contracts" ("deployer_address");
+CREATE INDEX IF NOT EXISTS "contract_idx_status" ON "contracts" ("status");
+CREATE TABLE IF NOT EXISTS "contract_updates" ("accept_transaction_hash" text,"block_number" bigint,"block_timestamp" bigint,"contract_address" text,"deployer_address" text,"is_creation" boolean,"reject_transaction_hash" text,"status" text,"transaction_hash" text,PRIMARY KEY ("transaction_hash"));
+CREATE INDEX IF NOT EXISTS "contract_update_idx_contract_address" ON "contract_updates" ("contract_address");
+
+CREATE TABLE IF NOT EXISTS "address_fee_stats" ("address" text,"bucket_timestamp" bigint,"fee_total" decimal,"step_used_total" bigint,"transaction_count" bigint,PRIMARY KEY ("address","bucket_timestamp"));
+CREATE TABLE IF NOT EXISTS "contract_fee_stats" ("bucket_timestamp" bigint,"contract_address" text,"fee_total" decimal,"step_used_total" bigint,"transaction_count" bigint,PRIMARY KEY ("bucket_timestamp","contract_address"));
+
+CREATE TABLE IF NOT EXISTS "holder_distributions" ("gini" decimal,"holder_count" bigint,"token_contract_address" text,"top100_percentage" decimal,"top10_percentage" decimal,"total_supply" text,"total_supply_decimal" decimal,"updated_timestamp" bigint,PRIMARY KEY ("token_contract_address"));
+
+CREATE TABLE IF NOT EXISTS "icx_balances" ("address" text,"updated_timestamp" bigint,"value" text,"value_decimal" decimal,PRIMARY KEY ("address"));
+CREATE INDEX IF NOT EXISTS "icx_balance_idx_value_decimal" ON "icx_balances" ("value_decimal");
+
+CREATE TABLE IF NOT EXISTS "kafka_jobs" ("job_id" text,"partition" bigint,"stop_offset" bigint,"topic" text,"worker_group" text,PRIMARY KEY ("job_id","partition","topic","worker_group"));
+
+CREATE TABLE IF NOT EXISTS "multi_token_holders" ("block_number" bigint,"holder_address" text,"token_contract_address" text,"token_id" text,"value" text,PRIMARY KEY ("holder_address","token_contract_address","token_id"));
+CREATE INDEX IF NOT EXISTS "multi_token_holders_idx_holder_address" ON "multi_token_holders" ("holder_address");
+CREATE INDEX IF NOT EXISTS "multi_token_holders_idx_token_contract_address" ON "multi_token_holders" ("token_contract_address");
+
+CREATE TABLE IF NOT EXISTS "multi_token_transfers" ("batch_index" integer,"block_number" bigint,"block_timestamp" bigint,"from_address" text,"log_index" integer,"operator_address" text,"to_address" text,"token_contract_address" text,"token_id" text,"transaction_hash" text,"value" text,PRIMARY KEY ("batch_index","log_index","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_block_number" ON "multi_token_transfers" ("block_number");
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_block_timestamp" ON "multi_token_transfers" ("block_timestamp");
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_from_address" ON "multi_token_transfers" ("from_address");
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_to_address" ON "multi_token_transfers" ("to_address");
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_token_contract_address" ON "multi_token_transfers" ("token_contract_address");
+CREATE INDEX IF NOT EXISTS "multi_token_transfer_idx_token_id" ON "multi_token_transfers" ("token_id");
+
+CREATE TABLE IF NOT EXISTS "token_contract_stats" ("bucket_timestamp" bigint,"holder_count" bigint,"token_contract_address" text,"transfer_count" bigint,"unique_receiver_count" bigint,"unique_sender_count" bigint,"volume" decimal,PRIMARY KEY ("bucket_timestamp","token_contract_address"));
+CREATE TABLE IF NOT EXISTS "token_contract_stat_addresses" ("address" text,"bucket_timestamp" bigint,"is_sender" boolean,"token_contract_address" text,PRIMARY KEY ("address","bucket_timestamp","is_sender","token_contract_address"));
+
+CREATE TABLE IF NOT EXISTS "token_holders" ("holder_address" text,"token_contract_address" text,"value" text,"value_decimal" decimal,PRIMARY KEY ("holder_address","token_contract_address"));
+CREATE INDEX IF NOT EXISTS "token_holders_idx_token_contract_address" ON "token_holders" ("token_contract_address");
+CREATE INDEX IF NOT EXISTS "token_holders_idx_value_decimal" ON "token_holders" ("value_decimal");
+
+CREATE TABLE IF NOT EXISTS "token_holder_checkpoints" ("block_number" bigint,"holder_address" text,"token_contract_address" text,"value" text,PRIMARY KEY ("block_number","holder_address","token_contract_address"));
+CREATE INDEX IF NOT EXISTS "token_holder_checkpoints_idx_holder_address" ON "token_holder_checkpoints" ("holder_address");
+CREATE INDEX IF NOT EXISTS "token_holder_checkpoints_idx_token_contract_address" ON "token_holder_checkpoints" ("token_contract_address");
+CREATE TABLE IF NOT EXISTS "token_holder_checkpoint_blocks" ("block_number" bigserial,PRIMARY KEY ("block_number"));
+
+CREATE TABLE IF NOT EXISTS "token_holder_count_by_token_contracts" ("count" bigint,"token_contract_address" text,PRIMARY KEY ("token_contract_address"));
+
+CREATE TABLE IF NOT EXISTS "token_transfers" ("block_number" bigint,"block_timestamp" bigint,"from_address" text,"log_index" integer,"to_address" text,"token_contract_address" text,"token_contract_name" text,"token_contract_symbol" text,"transaction_fee" text,"transaction_hash" text,"value" text,"value_decimal" decimal,PRIMARY KEY ("log_index","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "token_transfer_idx_block_number" ON "token_transfers" ("block_number");
+CREATE INDEX IF NOT EXISTS "token_transfer_idx_block_timestamp" ON "token_transfers" ("block_timestamp");
+CREATE INDEX IF NOT EXISTS "token_transfer_idx_from_address" ON "token_transfers" ("from_address");
+CREATE INDEX IF NOT EXISTS "token_transfer_idx_to_address" ON "token_transfers" ("to_address");
+CREATE INDEX IF NOT EXISTS "token_transfer_idx_token_contract_address" ON "token_transfers" ("token_contract_address");
+
+CREATE TABLE IF NOT EXISTS "token_transfer_count_by_addresses" ("address" text,"block_number" bigint,"count" bigint,"log_index" bigint,"transaction_hash" text,PRIMARY KEY ("address"));
+
+CREATE TABLE IF NOT EXISTS "token_transfer_count_by_address_indices" ("address" text,"block_number" bigint,"log_index" bigint,"transaction_hash" text,PRIMARY KEY ("address","log_index","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "token_transfer_count_by_address_index_idx_block_number" ON "token_transfer_count_by_address_indices" ("block_number");
+
+CREATE TABLE IF NOT EXISTS "token_transfer_count_by_token_contracts" ("block_number" bigint,"count" bigint,"log_index" bigint,"token_contract" text,"transaction_hash" text,PRIMARY KEY ("token_contract"));
+
+CREATE TABLE IF NOT EXISTS "token_transfer_count_by_token_contract_indices" ("block_number" bigint,"log_index" bigint,"token_contract" text,"transaction_hash" text,PRIMARY KEY ("log_index","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "token_transfer_count_by_token_contract_index_idx_block_number" ON "token_transfer_count_by_token_contract_indices" ("block_number");
+
+CREATE TABLE IF NOT EXISTS "transactions" ("block_hash" text,"block_number" bigint,"block_timestamp" bigint,"data" text,"data_type" text,"from_address" text,"hash" text,"item_id" text,"item_timestamp" text,"log_index" integer,"method" text,"nid" bigint,"nonce" text,"receipt_cumulative_step_used" bigint,"receipt_logs" text,"receipt_score_address" text,"receipt_status" bigint,"receipt_step_price" bigint,"receipt_step_used" bigint,"signature" text,"step_limit" bigint,"timestamp" text,"to_address" text,"transaction_fee" text,"transaction_index" bigint,"type" text,"value" text,"value_decimal" decimal,"version" text,PRIMARY KEY ("hash","log_index"));
+CREATE INDEX IF NOT EXISTS "transaction_idx_block_number" ON "transactions" ("block_number");
+CREATE INDEX IF NOT EXISTS "transaction_idx_block_timestamp" ON "transactions" ("block_timestamp");
+CREATE INDEX IF NOT EXISTS "transaction_idx_from_address" ON "transactions" ("from_address");
+CREATE INDEX IF NOT EXISTS "transaction_idx_method" ON "transactions" ("method");
+CREATE INDEX IF NOT EXISTS "transaction_idx_to_address" ON "transactions" ("to_address");
+CREATE INDEX IF NOT EXISTS "transaction_idx_type" ON "transactions" ("type");
+
+CREATE TABLE IF NOT EXISTS "transaction_counts" ("count" bigint,"log_index" integer,"transaction_hash" text,"type" text,PRIMARY KEY ("type"));
+
+CREATE TABLE IF NOT EXISTS "transaction_count_by_addresses" ("address" text,"block_number" bigint,"count" bigint,"transaction_hash" text,PRIMARY KEY ("address"));
+CREATE INDEX IF NOT EXISTS "transaction_count_by_address_idx_count" ON "transaction_count_by_addresses" ("count");
+
+CREATE TABLE IF NOT EXISTS "transaction_count_by_address_indices" ("address" text,"block_number" bigint,"transaction_hash" text,PRIMARY KEY ("address","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "transaction_count_by_address_index_idx_block_number" ON "transaction_count_by_address_indices" ("block_number");
+
+CREATE TABLE IF NOT EXISTS "transaction_count_indices" ("transaction_hash" text,PRIMARY KEY ("transaction_hash"));
+
+CREATE TABLE IF NOT EXISTS "transaction_create_scores" ("accept_transaction_hash" text,"creation_transaction_hash" text,"reject_transaction_hash" text,PRIMARY KEY ("creation_transaction_hash"));
+
+CREATE TABLE IF NOT EXISTS "transaction_internal_count_by_addresses" ("address" text,"block_number" bigint,"count" bigint,"log_index" bigint,"transaction_hash" text,PRIMARY KEY ("address"));
+
+CREATE TABLE IF NOT EXISTS "transaction_internal_count_by_address_indices" ("address" text,"block_number" bigint,"log_index" bigint,"transaction_hash" text,PRIMARY KEY ("address","log_index","transaction_hash"));
+CREATE INDEX IF NOT EXISTS "transaction_internal_count_by_address_index_idx_block_number" ON "transaction_internal_count_by_address_indices" ("block_number");
+
+CREATE TABLE IF NOT EXISTS "transaction_internal_count_indices" ("log_index" integer,"transaction_hash" text,PRIMARY KEY ("log_index","transaction_hash"));
+
+CREATE TABLE IF NOT EXISTS "transaction_missings" ("hash" text,PRIMARY KEY ("hash"));
+
+CREATE TABLE IF NOT EXISTS "transaction_stats" ("active_address_count" bigint,"average_step_price" decimal,"bucket_timestamp" bigint,"fee_total" decimal,"internal_transaction_count" bigint,"period" text,"step_price_total" bigint,"step_used_total" bigint,"token_transfer_count" bigint,"transaction_count" bigint,"value_total" decimal,PRIMARY KEY ("bucket_timestamp","period"));
+CREATE TABLE IF NOT EXISTS "transaction_stat_indices" ("log_index" integer,"transaction_hash" text,"type" text,PRIMARY KEY ("log_index","transaction_hash","type"));
+CREATE TABLE IF NOT EXISTS "transaction_stat_addresses" ("address" text,"bucket_timestamp" bigint,"period" text,PRIMARY KEY ("address","bucket_timestamp","period"));
+
+CREATE TABLE IF NOT EXISTS "transaction_token_transfer_count_indices" ("log_index" integer,"transaction_hash" text,PRIMARY KEY ("log_index","transaction_hash"));
+
+CREATE TABLE IF NOT EXISTS "transaction_websocket_indices" ("hash" text,PRIMARY KEY ("hash"));
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transaction_count_by_address_index_idx_address_block;
+DROP INDEX IF EXISTS transaction_internal_count_by_address_index_idx_address_block;
+DROP INDEX IF EXISTS token_transfer_count_by_address_index_idx_address_block;
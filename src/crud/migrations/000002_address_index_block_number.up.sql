@@ -0,0 +1,4 @@
+-- Counts by address in a block range, see CountByAddressBlockRange
+CREATE INDEX IF NOT EXISTS transaction_count_by_address_index_idx_address_block ON transaction_count_by_address_indices (address, block_number);
+CREATE INDEX IF NOT EXISTS transaction_internal_count_by_address_index_idx_address_block ON transaction_internal_count_by_address_indices (address, block_number);
+CREATE INDEX IF NOT EXISTS token_transfer_count_by_address_index_idx_address_block ON token_transfer_count_by_address_indices (address, block_number);
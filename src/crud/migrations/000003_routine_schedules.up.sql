@@ -0,0 +1,11 @@
+-- Schedules and last runs of the worker routines, see worker/scheduler
+CREATE TABLE IF NOT EXISTS routine_schedules (
+  name TEXT PRIMARY KEY,
+  schedule TEXT,
+  status TEXT,
+  error TEXT,
+  worker TEXT,
+  last_run_start_timestamp BIGINT,
+  last_run_end_timestamp BIGINT,
+  next_run_timestamp BIGINT
+);
@@ -0,0 +1,6 @@
+-- Progress of the worker routines, see worker/routines/checkpoints.go
+CREATE TABLE IF NOT EXISTS routine_checkpoints (
+  name TEXT PRIMARY KEY,
+  cursor TEXT,
+  updated_timestamp BIGINT
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS token_holder_checkpoints_idx_block_number;
+DROP INDEX IF EXISTS token_holder_checkpoints_idx_token_contract_address_value_decimal;
+ALTER TABLE token_holder_checkpoints DROP COLUMN IF EXISTS value_decimal;
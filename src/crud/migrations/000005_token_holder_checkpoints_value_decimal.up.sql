@@ -0,0 +1,7 @@
+-- Token holder balances at a block are sorted and paged by value_decimal, see SelectManyByTokenContractAddressAtBlock
+ALTER TABLE token_holder_checkpoints ADD COLUMN IF NOT EXISTS value_decimal DOUBLE PRECISION DEFAULT 0;
+CREATE INDEX IF NOT EXISTS token_holder_checkpoints_idx_token_contract_address_value_decimal ON token_holder_checkpoints (token_contract_address, value_decimal DESC);
+-- Checkpoints at or above a block are deleted by late token transfers
+CREATE INDEX IF NOT EXISTS token_holder_checkpoints_idx_block_number ON token_holder_checkpoints (block_number);
+-- Existing checkpoints have no value_decimal, the routine rebuilds them
+TRUNCATE token_holder_checkpoints, token_holder_checkpoint_blocks;
@@ -0,0 +1,67 @@
+//+build unit
+
+package crud
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSQLMigrations(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"migrations/000002_add_index.up.sql":    {Data: []byte("CREATE INDEX a ON b (c);")},
+		"migrations/000002_add_index.down.sql":  {Data: []byte("DROP INDEX a;")},
+		"migrations/000003_concurrent.up.sql":   {Data: []byte(migrationNoTransaction + "\nCREATE INDEX CONCURRENTLY d ON b (e);")},
+		"migrations/000003_concurrent.down.sql": {Data: []byte("DROP INDEX d;")},
+	}
+
+	migrations, err := readSQLMigrations(fsys, "migrations")
+	assert.Equal(nil, err)
+	assert.Equal(2, len(migrations))
+
+	for _, m := range migrations {
+		assert.NotNil(m.up)
+		assert.NotNil(m.down)
+
+		switch m.version {
+		case 2:
+			assert.Equal("add_index", m.name)
+			assert.Equal(false, m.noTransactionUp)
+		case 3:
+			assert.Equal("concurrent", m.name)
+			assert.Equal(true, m.noTransactionUp)
+			assert.Equal(false, m.noTransactionDown)
+		default:
+			t.Error("unexpected version ", m.version)
+		}
+	}
+
+	// Missing up migration
+	_, err = readSQLMigrations(fstest.MapFS{
+		"migrations/000004_missing.down.sql": {Data: []byte("SELECT 1;")},
+	}, "migrations")
+	assert.NotEqual(nil, err)
+
+	// Invalid name
+	_, err = readSQLMigrations(fstest.MapFS{
+		"migrations/add_index.sql": {Data: []byte("SELECT 1;")},
+	}, "migrations")
+	assert.NotEqual(nil, err)
+}
+
+func TestGetMigrations(t *testing.T) {
+	assert := assert.New(t)
+
+	migrations, err := getMigrations()
+	assert.Equal(nil, err)
+	assert.Equal(int64(1), migrations[0].version)
+	assert.Equal("baseline", migrations[0].name)
+
+	for i := 1; i < len(migrations); i++ {
+		assert.Less(migrations[i-1].version, migrations[i].version)
+	}
+}
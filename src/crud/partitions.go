@@ -0,0 +1,565 @@
+package crud
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// partitionedTable - table range partitioned by block_number when DB_PARTITION_SIZE is set
+// NOTE primary keys of partitioned tables include block_number
+type partitionedTable struct {
+	db          *gorm.DB
+	name        string
+	primaryKeys []string
+	partitioned int32
+}
+
+func newPartitionedTable(db *gorm.DB, name string, primaryKeys ...string) *partitionedTable {
+	return &partitionedTable{
+		db:          usePrimary(db),
+		name:        name,
+		primaryKeys: primaryKeys,
+	}
+}
+
+// partitionedTables - tables partitioned by block number
+func partitionedTables() []*partitionedTable {
+	return []*partitionedTable{
+		GetTransactionModel().partitions,
+		GetTokenTransferModel().partitions,
+		GetTransactionCountByAddressIndexModel().partitions,
+		GetTransactionInternalCountByAddressIndexModel().partitions,
+		GetTokenTransferCountByAddressIndexModel().partitions,
+	}
+}
+
+// CreatePartitions - create partitions up to DB_PARTITIONS_AHEAD past the tip
+// NOTE only tables already partitioned, see PartitionTables
+func CreatePartitions(tipBlockNumber uint64) error {
+	for _, p := range partitionedTables() {
+		err := p.refresh()
+		if err != nil {
+			return err
+		}
+
+		if p.isPartitioned() == false {
+			continue
+		}
+
+		err = p.createPartitions(p.name, tipBlockNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PartitionTables - partition tables online, then create partitions past the tip
+// NOTE tables are copied by block range, this may take hours on a new deployment of partitions
+func PartitionTables(tipBlockNumber uint64) error {
+	for _, p := range partitionedTables() {
+		err := p.partition(tipBlockNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func partitionsEnabled() bool {
+	return config.Config.DbPartitionSize > 0
+}
+
+// partitionRanges - start block numbers of the partitions needed to hold highestBlockNumber
+func partitionRanges(highestBlockNumber uint64) []uint64 {
+	size := config.Config.DbPartitionSize
+	end := (highestBlockNumber/size + 1 + config.Config.DbPartitionsAhead) * size
+
+	ranges := []uint64{}
+	for from := uint64(0); from < end; from += size {
+		ranges = append(ranges, from)
+	}
+
+	return ranges
+}
+
+func (p *partitionedTable) isPartitioned() bool {
+	return atomic.LoadInt32(&p.partitioned) == 1
+}
+
+// refresh - load if the table is partitioned
+// NOTE tables are partitioned by one worker while others are running
+func (p *partitionedTable) refresh() error {
+	relkind := ""
+	err := p.db.Raw("SELECT COALESCE((SELECT relkind::text FROM pg_class WHERE oid = to_regclass(?)), '')", p.name).Row().Scan(&relkind)
+	if err != nil {
+		return err
+	}
+
+	partitioned := int32(0)
+	if relkind == "p" {
+		partitioned = 1
+	}
+	atomic.StoreInt32(&p.partitioned, partitioned)
+
+	return nil
+}
+
+// conflictColumns - primary keys of the table, for upserts
+func (p *partitionedTable) conflictColumns() []clause.Column {
+	columns := []clause.Column{}
+	for _, key := range p.primaryKeys {
+		columns = append(columns, clause.Column{Name: key})
+	}
+
+	if p.isPartitioned() {
+		columns = append(columns, clause.Column{Name: "block_number"})
+	}
+
+	return columns
+}
+
+// upsert - create value, updating the columns of updates on conflict
+// NOTE once partitioned, rows of the primary keys at another block are replaced in the same transaction
+// Rows at block 0, ex created by reloadTransaction, are skipped if the row was loaded
+func (p *partitionedTable) upsert(db *gorm.DB, value interface{}, updates map[string]interface{}) error {
+	create := func(db *gorm.DB) error {
+		return db.Clauses(clause.OnConflict{
+			Columns:   p.conflictColumns(),
+			DoUpdates: clause.Assignments(updates),
+		}).Create(value).Error
+	}
+
+	upsert := func() error {
+		if p.isPartitioned() == false {
+			return create(db)
+		}
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			where, args, blockNumber, err := p.otherBlocksCondition(tx, value)
+			if err != nil {
+				return err
+			}
+
+			if blockNumber == 0 {
+				exists := false
+				err = tx.Raw("SELECT EXISTS (SELECT 1 FROM "+quoteIdentifier(p.name)+" WHERE "+where+")", args...).Row().Scan(&exists)
+				if err != nil || exists {
+					return err
+				}
+			} else {
+				err = tx.Exec("DELETE FROM "+quoteIdentifier(p.name)+" WHERE "+where, args...).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			return create(tx)
+		})
+	}
+
+	err := upsert()
+	if err != nil && strings.Contains(err.Error(), "no unique or exclusion constraint matching") {
+		// Partitioned by another worker
+		refreshErr := p.refresh()
+		if refreshErr != nil {
+			return err
+		}
+
+		err = upsert()
+	}
+
+	return err
+}
+
+// otherBlocksCondition - where clause of the rows with the primary keys of value at another block
+// Returns: where clause, args, block number of value, error (if present)
+func (p *partitionedTable) otherBlocksCondition(db *gorm.DB, value interface{}) (string, []interface{}, uint64, error) {
+	stmt := &gorm.Statement{DB: db}
+	err := stmt.Parse(value)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	reflectValue := reflect.Indirect(reflect.ValueOf(value))
+
+	blockNumberField := stmt.Schema.LookUpField("block_number")
+	if blockNumberField == nil {
+		return "", nil, 0, fmt.Errorf("no block_number column in %s", p.name)
+	}
+	blockNumberValue, _ := blockNumberField.ValueOf(reflectValue)
+	blockNumber, _ := blockNumberValue.(uint64)
+
+	conditions := []string{"block_number <> ?"}
+	args := []interface{}{blockNumber}
+	for _, key := range p.primaryKeys {
+		field := stmt.Schema.LookUpField(key)
+		if field == nil {
+			return "", nil, 0, fmt.Errorf("no %s column in %s", key, p.name)
+		}
+		keyValue, _ := field.ValueOf(reflectValue)
+
+		conditions = append(conditions, quoteIdentifier(key)+" = ?")
+		args = append(args, keyValue)
+	}
+
+	return strings.Join(conditions, " AND "), args, blockNumber, nil
+}
+
+// migrate - load the state of the table and partition it if empty
+// NOTE tables with rows are partitioned online by the worker, see PartitionTables
+func (p *partitionedTable) migrate() error {
+	err := p.refresh()
+	if err != nil || partitionsEnabled() == false || p.isPartitioned() {
+		return err
+	}
+
+	empty := false
+	err = p.db.Raw("SELECT NOT EXISTS (SELECT 1 FROM " + quoteIdentifier(p.name) + ")").Row().Scan(&empty)
+	if err != nil || empty == false {
+		return err
+	}
+
+	return p.partition(0)
+}
+
+// partition - partition the table online if needed and create partitions past the tip
+func (p *partitionedTable) partition(tipBlockNumber uint64) error {
+	if partitionsEnabled() == false {
+		return nil
+	}
+
+	err := p.refresh()
+	if err != nil {
+		return err
+	}
+
+	if p.isPartitioned() == false {
+		// NOTE one process partitions a table at a time
+		unlock, locked, err := advisoryLock(p.db, "partition:"+p.name, false)
+		if err != nil || locked == false {
+			return err
+		}
+		defer unlock()
+
+		err = p.refresh()
+		if err != nil {
+			return err
+		}
+
+		if p.isPartitioned() == false {
+			err = p.copyTable(tipBlockNumber)
+			if err != nil {
+				return err
+			}
+
+			err = p.swapTable()
+			if err != nil {
+				return err
+			}
+
+			err = p.refresh()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.createPartitions(p.name, tipBlockNumber)
+}
+
+// createPartitions - create the missing partitions of parent up to the highest block number
+func (p *partitionedTable) createPartitions(parent string, tipBlockNumber uint64) error {
+	highestBlockNumber := uint64(0)
+	err := p.db.Raw("SELECT COALESCE(MAX(block_number), 0) FROM " + quoteIdentifier(parent)).Row().Scan(&highestBlockNumber)
+	if err != nil {
+		return err
+	}
+	if tipBlockNumber > highestBlockNumber {
+		highestBlockNumber = tipBlockNumber
+	}
+
+	partitions := []string{}
+	err = p.db.Raw(
+		"SELECT c.relname::text FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = to_regclass(?)",
+		parent,
+	).Scan(&partitions).Error
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, partition := range partitions {
+		existing[partition] = true
+	}
+
+	size := config.Config.DbPartitionSize
+	for _, from := range partitionRanges(highestBlockNumber) {
+		// NOTE named after the table, partitions keep their name when the table is swapped
+		partition := p.name + "_p" + strconv.FormatUint(from, 10)
+		if existing[partition] {
+			continue
+		}
+
+		err = p.db.Exec(
+			"CREATE TABLE " + quoteIdentifier(partition) + " PARTITION OF " + quoteIdentifier(parent) +
+				" FOR VALUES FROM (" + strconv.FormatUint(from, 10) + ") TO (" + strconv.FormatUint(from+size, 10) + ")",
+		).Error
+		if err != nil {
+			return err
+		}
+
+		zap.S().Info("Partitions: created ", partition)
+	}
+
+	return nil
+}
+
+// copyTable - copy the table into a partitioned staging table
+// NOTE a trigger keeps the staging table in sync with writes during the copy
+// NOTE rows without a block number are copied to block 0
+func (p *partitionedTable) copyTable(tipBlockNumber uint64) error {
+	staging := p.name + "_partitioned"
+
+	err := p.createStagingTable(staging)
+	if err != nil {
+		return err
+	}
+
+	highestBlockNumber := uint64(0)
+	err = p.db.Raw("SELECT COALESCE(MAX(block_number), 0) FROM " + quoteIdentifier(p.name)).Row().Scan(&highestBlockNumber)
+	if err != nil {
+		return err
+	}
+	if tipBlockNumber > highestBlockNumber {
+		highestBlockNumber = tipBlockNumber
+	}
+
+	err = p.createPartitions(staging, highestBlockNumber)
+	if err != nil {
+		return err
+	}
+
+	// Columns
+	columns := []string{}
+	err = p.db.Raw(
+		"SELECT column_name::text FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ? ORDER BY ordinal_position",
+		p.name,
+	).Scan(&columns).Error
+	if err != nil {
+		return err
+	}
+
+	insertColumns := []string{}
+	selectColumns := []string{}
+	for _, column := range columns {
+		insertColumns = append(insertColumns, quoteIdentifier(column))
+
+		if column == "block_number" {
+			selectColumns = append(selectColumns, "COALESCE(block_number, 0)")
+		} else {
+			selectColumns = append(selectColumns, quoteIdentifier(column))
+		}
+	}
+
+	// NOTE FOR SHARE holds updates of copied rows until the batch commits, the trigger then applies them
+	copyStatement := "INSERT INTO " + quoteIdentifier(staging) + " (" + strings.Join(insertColumns, ", ") + ")" +
+		" SELECT " + strings.Join(selectColumns, ", ") + " FROM " + quoteIdentifier(p.name) +
+		" WHERE %s FOR SHARE ON CONFLICT DO NOTHING"
+
+	// Rows without a block number
+	err = p.db.Exec(fmt.Sprintf(copyStatement, "block_number IS NULL")).Error
+	if err != nil {
+		return err
+	}
+
+	batchSize := config.Config.DbPartitionBatchSize
+	for from := uint64(0); from <= highestBlockNumber; from += batchSize {
+		err = p.db.Exec(
+			fmt.Sprintf(copyStatement, "block_number >= ? AND block_number < ?"),
+			from, from+batchSize,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		// NOTE new blocks are written to the staging table by the trigger
+		if (from/batchSize)%100 == 99 {
+			zap.S().Info("Partitions: copied ", p.name, " to block ", from+batchSize)
+
+			err = p.createPartitions(staging, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	zap.S().Info("Partitions: copied ", p.name)
+	return nil
+}
+
+// createStagingTable - create the partitioned copy of the table, its indexes and sync trigger
+// NOTE resumes a copy of a process that exited
+func (p *partitionedTable) createStagingTable(staging string) error {
+	primaryKeys := []string{}
+	syncConditions := []string{}
+	for _, key := range p.primaryKeys {
+		primaryKeys = append(primaryKeys, quoteIdentifier(key))
+		syncConditions = append(syncConditions, quoteIdentifier(key)+" = OLD."+quoteIdentifier(key))
+	}
+	primaryKeys = append(primaryKeys, "block_number")
+
+	syncFunction := quoteIdentifier(p.name + "_partition_sync")
+	syncTrigger := quoteIdentifier(p.name + "_partition_sync")
+
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		exists := false
+		err := tx.Raw("SELECT to_regclass(?) IS NOT NULL", staging).Row().Scan(&exists)
+		if err != nil {
+			return err
+		}
+
+		if exists == false {
+			err = tx.Exec(
+				"CREATE TABLE " + quoteIdentifier(staging) + " (LIKE " + quoteIdentifier(p.name) + " INCLUDING DEFAULTS)" +
+					" PARTITION BY RANGE (block_number)",
+			).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec("ALTER TABLE " + quoteIdentifier(staging) + " ADD PRIMARY KEY (" + strings.Join(primaryKeys, ", ") + ")").Error
+			if err != nil {
+				return err
+			}
+
+			// Indexes
+			// NOTE renamed to the names of the table when swapped
+			indexes := []struct {
+				Name       string
+				Definition string
+			}{}
+			err = tx.Raw(
+				"SELECT c.relname::text AS name, pg_get_indexdef(x.indexrelid) AS definition"+
+					" FROM pg_index x JOIN pg_class c ON c.oid = x.indexrelid"+
+					" WHERE x.indrelid = to_regclass(?) AND x.indisprimary = false",
+				p.name,
+			).Scan(&indexes).Error
+			if err != nil {
+				return err
+			}
+
+			for _, index := range indexes {
+				using := strings.Index(index.Definition, " USING ")
+				if using == -1 {
+					continue
+				}
+
+				err = tx.Exec(
+					"CREATE INDEX " + quoteIdentifier(index.Name+"_p") + " ON " + quoteIdentifier(staging) + index.Definition[using:],
+				).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Sync trigger
+		err = tx.Exec(
+			"CREATE OR REPLACE FUNCTION " + syncFunction + "() RETURNS trigger AS $$\n" +
+				"BEGIN\n" +
+				"	IF TG_OP <> 'INSERT' THEN\n" +
+				"		DELETE FROM " + quoteIdentifier(staging) + " WHERE " + strings.Join(syncConditions, " AND ") + ";\n" +
+				"	END IF;\n" +
+				"	IF TG_OP <> 'DELETE' THEN\n" +
+				"		NEW.block_number := COALESCE(NEW.block_number, 0);\n" +
+				"		INSERT INTO " + quoteIdentifier(staging) + " VALUES (NEW.*) ON CONFLICT DO NOTHING;\n" +
+				"	END IF;\n" +
+				"	RETURN NULL;\n" +
+				"END\n" +
+				"$$ LANGUAGE plpgsql",
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("DROP TRIGGER IF EXISTS " + syncTrigger + " ON " + quoteIdentifier(p.name)).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(
+			"CREATE TRIGGER " + syncTrigger + " AFTER INSERT OR UPDATE OR DELETE ON " + quoteIdentifier(p.name) +
+				" FOR EACH ROW EXECUTE PROCEDURE " + syncFunction + "()",
+		).Error
+	})
+}
+
+// swapTable - replace the table with the staging table
+// NOTE the table is kept as <table>_unpartitioned without its indexes, drop it once verified
+func (p *partitionedTable) swapTable() error {
+	staging := p.name + "_partitioned"
+	unpartitioned := p.name + "_unpartitioned"
+
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("LOCK TABLE " + quoteIdentifier(p.name) + " IN ACCESS EXCLUSIVE MODE").Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("DROP TRIGGER " + quoteIdentifier(p.name+"_partition_sync") + " ON " + quoteIdentifier(p.name)).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("DROP FUNCTION " + quoteIdentifier(p.name+"_partition_sync") + "()").Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("ALTER TABLE " + quoteIdentifier(p.name) + " RENAME TO " + quoteIdentifier(unpartitioned)).Error
+		if err != nil {
+			return err
+		}
+
+		// Indexes
+		indexes := []string{}
+		err = tx.Raw(
+			"SELECT c.relname::text FROM pg_index x JOIN pg_class c ON c.oid = x.indexrelid WHERE x.indrelid = to_regclass(?) AND x.indisprimary = false",
+			unpartitioned,
+		).Scan(&indexes).Error
+		if err != nil {
+			return err
+		}
+
+		for _, index := range indexes {
+			err = tx.Exec("DROP INDEX " + quoteIdentifier(index)).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec("ALTER INDEX IF EXISTS " + quoteIdentifier(index+"_p") + " RENAME TO " + quoteIdentifier(index)).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec("ALTER TABLE " + quoteIdentifier(staging) + " RENAME TO " + quoteIdentifier(p.name)).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	zap.S().Info("Partitions: partitioned ", p.name, ", drop ", unpartitioned, " once verified")
+	return nil
+}
@@ -0,0 +1,64 @@
+//+build unit
+
+package crud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+func TestPartitionRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.DbPartitionSize = 1000
+	config.Config.DbPartitionsAhead = 2
+	defer func() { config.Config.DbPartitionSize = 0 }()
+
+	assert.Equal([]uint64{0, 1000, 2000}, partitionRanges(0))
+	assert.Equal([]uint64{0, 1000, 2000, 3000, 4000}, partitionRanges(2500))
+}
+
+func TestPartitionedTableConflictColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &partitionedTable{
+		name:        "transactions",
+		primaryKeys: []string{"hash", "log_index"},
+	}
+
+	assert.Equal([]clause.Column{{Name: "hash"}, {Name: "log_index"}}, p.conflictColumns())
+
+	p.partitioned = 1
+	assert.Equal([]clause.Column{{Name: "hash"}, {Name: "log_index"}, {Name: "block_number"}}, p.conflictColumns())
+}
+
+func TestPartitionedTableOtherBlocksCondition(t *testing.T) {
+	assert := assert.New(t)
+
+	// NOTE never connects
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DisableAutomaticPing: true})
+	assert.Equal(nil, err)
+
+	p := &partitionedTable{
+		name:        "transactions",
+		primaryKeys: []string{"hash", "log_index"},
+	}
+
+	where, args, blockNumber, err := p.otherBlocksCondition(db, &models.Transaction{Hash: "0x1", LogIndex: -1, BlockNumber: 10})
+	assert.Equal(nil, err)
+	assert.Equal(`block_number <> ? AND "hash" = ? AND "log_index" = ?`, where)
+	assert.Equal([]interface{}{uint64(10), "0x1", int32(-1)}, args)
+	assert.Equal(uint64(10), blockNumber)
+
+	// Unknown key
+	p.primaryKeys = []string{"address"}
+	_, _, _, err = p.otherBlocksCondition(db, &models.Transaction{Hash: "0x1"})
+	assert.NotEqual(nil, err)
+}
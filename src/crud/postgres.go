@@ -1,9 +1,11 @@
 package crud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +26,16 @@ func formatPostgresDSN(host string, port string, user string, password string, d
 		host, user, password, dbname, port, sslmode, timezone)
 }
 
+// formatPostgresSchemaDSN - dsn with the search path set to a schema
+// NOTE tables of each network are isolated in their own schema
+func formatPostgresSchemaDSN(dsn string, schema string) string {
+	if schema == "" {
+		return dsn
+	}
+
+	return dsn + " search_path=" + schema
+}
+
 func getPostgresConn() *gorm.DB {
 	postgresSessionOnce.Do(func() {
 		dsn := formatPostgresDSN(
@@ -37,17 +49,57 @@ func getPostgresConn() *gorm.DB {
 		)
 
 		var err error
-		postgresSession, err = retryGetPostgresSession(dsn)
+		if config.Config.DbSchema != "" {
+			err = createPostgresSchema(dsn, config.Config.DbSchema)
+			if err != nil {
+				zap.S().Fatal("Cannot create postgres schema ", config.Config.DbSchema, ": ", err)
+			}
+		}
+
+		postgresSession, err = retryGetPostgresSession(formatPostgresSchemaDSN(dsn, config.Config.DbSchema))
 		if err != nil {
 			zap.S().Fatal("Cannot create a connection to postgres", err)
 		}
 
 		zap.S().Info("Successful connection to postgres")
+
+		// Read replicas
+		if len(config.Config.DbReadHosts) > 0 {
+			replicas := newReadReplicas(config.Config.DbReadHosts)
+
+			err = postgresSession.Use(replicas)
+			if err != nil {
+				zap.S().Fatal("Cannot use postgres replicas: ", err)
+			}
+
+			zap.S().Info("Routing reads to ", len(replicas.replicas), " postgres replicas")
+		}
 	})
 
 	return postgresSession
 }
 
+// createPostgresSchema - create a schema if missing
+func createPostgresSchema(dsn string, schema string) error {
+	session, err := retryGetPostgresSession(dsn)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := session.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	return session.Exec("CREATE SCHEMA IF NOT EXISTS " + quoteIdentifier(schema)).Error
+}
+
+// quoteIdentifier - quote a postgres identifier, ex a table name
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
 func retryGetPostgresSession(dsn string) (*gorm.DB, error) {
 	var session *gorm.DB
 	operation := func() error {
@@ -88,3 +140,55 @@ func createSession(dsn string) (*gorm.DB, error) {
 
 	return db, err
 }
+
+// advisoryLock - take a postgres advisory lock, waiting for it if wait is set
+// Returns: unlock, locked, error (if present)
+// NOTE keys are scoped to the schema, networks share the database
+func advisoryLock(db *gorm.DB, key string, wait bool) (func(), bool, error) {
+	key = config.Config.DbSchema + ":" + key
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// NOTE advisory locks belong to a connection
+	ctx := context.Background()
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	locked := true
+	if wait {
+		_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", key)
+	} else {
+		err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", key).Scan(&locked)
+	}
+	if err != nil || locked == false {
+		conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", key)
+		if err != nil {
+			zap.S().Warn("Unable to unlock ", key, ": ", err.Error())
+		}
+		conn.Close()
+	}
+
+	return unlock, true, nil
+}
+
+// advisoryTransactionLock - take a postgres advisory lock until the end of tx
+// NOTE shared locks are held together, an exclusive lock waits for all of them
+func advisoryTransactionLock(tx *gorm.DB, key string, shared bool) error {
+	key = config.Config.DbSchema + ":" + key
+
+	if shared {
+		return tx.Exec("SELECT pg_advisory_xact_lock_shared(hashtext(?))", key).Error
+	}
+
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key).Error
+}
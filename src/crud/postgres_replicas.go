@@ -0,0 +1,187 @@
+package crud
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+const settingPrimary = "crud:primary"
+
+// NOTE 0 when caught up, null on a primary
+const replicaLagQuery = `SELECT COALESCE(
+	CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()) END, 0)`
+
+// readReplicas - gorm plugin routing reads to healthy replicas, round robin
+// NOTE writes, raw sql and transactions stay on the primary
+type readReplicas struct {
+	replicas []*readReplica
+	next     uint64
+}
+
+type readReplica struct {
+	host    string
+	session *gorm.DB
+	healthy int32
+}
+
+// usePrimary - route every statement of db to the primary, ex migrations
+func usePrimary(db *gorm.DB) *gorm.DB {
+	return db.Set(settingPrimary, true).Session(&gorm.Session{})
+}
+
+// parsePostgresHost - host and port of a DB_READ_HOSTS value, host or host:port
+func parsePostgresHost(host string, defaultPort string) (string, string) {
+	hostPort := strings.SplitN(strings.TrimSpace(host), ":", 2)
+	if len(hostPort) == 2 && hostPort[1] != "" {
+		return hostPort[0], hostPort[1]
+	}
+
+	return hostPort[0], defaultPort
+}
+
+func newReadReplicas(hosts []string) *readReplicas {
+	r := &readReplicas{}
+
+	for _, h := range hosts {
+		host, port := parsePostgresHost(h, config.Config.DbPort)
+		if host == "" {
+			continue
+		}
+
+		dsn := formatPostgresDSN(
+			host,
+			port,
+			config.Config.DbUser,
+			config.Config.DbPassword,
+			config.Config.DbName,
+			config.Config.DbSslmode,
+			config.Config.DbTimezone,
+		)
+
+		// NOTE replicas down on start are retried by the health checks
+		session, err := createSession(formatPostgresSchemaDSN(dsn, config.Config.DbSchema))
+		if session == nil {
+			zap.S().Warn("Cannot create a connection to postgres replica ", host, ": ", err)
+			continue
+		}
+
+		r.replicas = append(r.replicas, &readReplica{
+			host:    host + ":" + port,
+			session: session,
+		})
+	}
+
+	return r
+}
+
+// Name - gorm plugin name
+func (r *readReplicas) Name() string {
+	return "crud:read_replicas"
+}
+
+// Initialize - register the routing callbacks
+func (r *readReplicas) Initialize(db *gorm.DB) error {
+	err := db.Callback().Query().Before("gorm:query").Register("crud:read_replicas_query", r.route)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().Before("gorm:row").Register("crud:read_replicas_row", r.route)
+	if err != nil {
+		return err
+	}
+
+	for _, replica := range r.replicas {
+		replica.check()
+	}
+	go r.startHealthChecks()
+
+	return nil
+}
+
+func (r *readReplicas) route(db *gorm.DB) {
+	if _, ok := db.Get(settingPrimary); ok {
+		return
+	}
+
+	// Transactions
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+
+	// Raw sql
+	if db.Statement.SQL.Len() > 0 {
+		return
+	}
+
+	// Locking reads
+	if _, ok := db.Statement.Clauses["FOR"]; ok {
+		return
+	}
+
+	replica := r.pick()
+	if replica == nil {
+		// No healthy replicas, use the primary
+		return
+	}
+
+	sqlDB, err := replica.session.DB()
+	if err != nil {
+		return
+	}
+
+	db.Statement.ConnPool = sqlDB
+}
+
+// pick - next healthy replica, nil if none
+func (r *readReplicas) pick() *readReplica {
+	n := uint64(len(r.replicas))
+	start := atomic.AddUint64(&r.next, 1)
+
+	for i := uint64(0); i < n; i++ {
+		replica := r.replicas[(start+i)%n]
+		if atomic.LoadInt32(&replica.healthy) == 1 {
+			return replica
+		}
+	}
+
+	return nil
+}
+
+func (r *readReplicas) startHealthChecks() {
+	interval := time.Duration(config.Config.DbReadHealthCheckSeconds) * time.Second
+
+	for {
+		time.Sleep(interval)
+
+		for _, replica := range r.replicas {
+			replica.check()
+		}
+	}
+}
+
+// check - mark a replica healthy if reachable and within DB_READ_MAX_LAG_SECONDS
+func (replica *readReplica) check() {
+	lag := float64(0)
+	err := replica.session.Raw(replicaLagQuery).Row().Scan(&lag)
+
+	healthy := int32(1)
+	if err != nil {
+		zap.S().Warn("Postgres replica ", replica.host, " unhealthy: ", err.Error())
+		healthy = 0
+	} else if config.Config.DbReadMaxLagSeconds > 0 && lag > float64(config.Config.DbReadMaxLagSeconds) {
+		zap.S().Warn("Postgres replica ", replica.host, " lagging: ", lag, "s")
+		healthy = 0
+	}
+
+	if atomic.SwapInt32(&replica.healthy, healthy) != healthy && healthy == 1 {
+		zap.S().Info("Postgres replica ", replica.host, " healthy")
+	}
+}
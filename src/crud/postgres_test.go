@@ -16,6 +16,12 @@ func init() {
 
 	// Set up logging
 	logging.Init()
+
+	// Migrate schema
+	err := MigrateUp(0)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func TestFormatPostgresDSN(t *testing.T) {
@@ -46,6 +52,49 @@ func TestFormatPostgresDSN(t *testing.T) {
 	assert.Equal(dsn, formatPostgresDSN(host, port, user, password, dbname, sslmode, timezone))
 }
 
+func TestFormatPostgresSchemaDSN(t *testing.T) {
+	assert := assert.New(t)
+
+	dsn := "host=localhost user=postgres password=changeme dbname=postgres port=5432 sslmode=disable TimeZone=UTC"
+
+	assert.Equal(dsn, formatPostgresSchemaDSN(dsn, ""))
+	assert.Equal(dsn+" search_path=lisbon", formatPostgresSchemaDSN(dsn, "lisbon"))
+}
+
+func TestParsePostgresHost(t *testing.T) {
+	assert := assert.New(t)
+
+	host, port := parsePostgresHost("replica-1", "5432")
+	assert.Equal("replica-1", host)
+	assert.Equal("5432", port)
+
+	host, port = parsePostgresHost(" replica-2:5433", "5432")
+	assert.Equal("replica-2", host)
+	assert.Equal("5433", port)
+}
+
+func TestReadReplicasPick(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &readReplicas{
+		replicas: []*readReplica{
+			{host: "replica-1", healthy: 1},
+			{host: "replica-2", healthy: 0},
+			{host: "replica-3", healthy: 1},
+		},
+	}
+
+	// Round robin over healthy replicas
+	assert.Equal("replica-3", r.pick().host)
+	assert.Equal("replica-3", r.pick().host)
+	assert.Equal("replica-1", r.pick().host)
+
+	// No healthy replicas
+	r.replicas[0].healthy = 0
+	r.replicas[2].healthy = 0
+	assert.Equal((*readReplica)(nil), r.pick())
+}
+
 func TestGetPostgressConn(t *testing.T) {
 	assert := assert.New(t)
 
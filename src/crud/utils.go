@@ -1,10 +1,19 @@
 package crud
 
 import (
+	"encoding/json"
+	"errors"
+	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
 )
 
+// Token mints come from and burns go to the zero address
+const tokenZeroAddress = "hx0000000000000000000000000000000000000000"
+
 func extractFilledFieldsFromModel(modelValueOf reflect.Value, modelTypeOf reflect.Type) map[string]interface{} {
 
 	fields := map[string]interface{}{}
@@ -180,3 +189,194 @@ func formatSelectStatementForceCTE(
 
 	return rawSQL
 }
+
+// hexToBigInt - parse a 0x prefixed hex string (ex. "0x1a" or "-0x1a")
+func hexToBigInt(hex string) (*big.Int, error) {
+	isNegative := strings.HasPrefix(hex, "-")
+	hex = strings.TrimPrefix(hex, "-")
+	hex = strings.TrimPrefix(hex, "0x")
+
+	if hex == "" {
+		return big.NewInt(0), nil
+	}
+
+	value, success := new(big.Int).SetString(hex, 16)
+	if success == false {
+		return nil, errors.New("Invalid hex string: " + hex)
+	}
+
+	if isNegative == true {
+		value = value.Neg(value)
+	}
+
+	return value, nil
+}
+
+// bigIntToHex - format a big int as a 0x prefixed hex string
+func bigIntToHex(value *big.Int) string {
+	if value.Sign() < 0 {
+		return "-0x" + new(big.Int).Abs(value).Text(16)
+	}
+
+	return "0x" + value.Text(16)
+}
+
+// bigIntToFloat64 - convert a big int to a float64 with base decimals
+func bigIntToFloat64(value *big.Int, base int) float64 {
+	baseBigInt := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(base)), nil) // 10^(base)
+
+	valueBigFloat := new(big.Float).SetInt(value)
+	valueBigFloat = valueBigFloat.Quo(valueBigFloat, new(big.Float).SetInt(baseBigInt))
+
+	valueDecimal, _ := valueBigFloat.Float64()
+
+	return valueDecimal
+}
+
+// hexColumnCompare - where clause comparing a 0x prefixed hex column to a value
+// NOTE hex without leading zeros orders by length then by text
+// Takes the value 3 times as args
+func hexColumnCompare(column string, operator string) string {
+	lengthOperator := operator[:1]
+
+	return "(length(" + column + ") " + lengthOperator + " length(?) OR " +
+		"(length(" + column + ") = length(?) AND " + column + " COLLATE \"C\" " + operator + " ?))"
+}
+
+// hexColumnOrder - order clause for a 0x prefixed hex column
+func hexColumnOrder(column string, sort string) string {
+	return "length(" + column + ") " + sort + ", " + column + " COLLATE \"C\" " + sort
+}
+
+// StreamOptions - column selection and ranges for stream queries
+type StreamOptions struct {
+	Columns          []string
+	StartBlockNumber int
+	EndBlockNumber   int
+	StartTimestamp   int64 // block timestamp in micro seconds
+	EndTimestamp     int64 // block timestamp in micro seconds
+	Limit            int   // max rows, 0 for no limit
+}
+
+// applyStreamOptions - add the stream options to a query
+// NOTE ranges are skipped if the table has no matching column
+func applyStreamOptions(
+	db *gorm.DB,
+	options *StreamOptions,
+	blockNumberColumn string,
+	blockTimestampColumn string,
+) *gorm.DB {
+
+	// Columns
+	if len(options.Columns) > 0 {
+		db = db.Select(options.Columns)
+	}
+
+	// Limit
+	if options.Limit > 0 {
+		db = db.Limit(options.Limit)
+	}
+
+	if blockNumberColumn != "" {
+		// start block number
+		if options.StartBlockNumber != 0 {
+			db = db.Where(blockNumberColumn+" >= ?", options.StartBlockNumber)
+		}
+
+		// end block number
+		if options.EndBlockNumber != 0 {
+			db = db.Where(blockNumberColumn+" <= ?", options.EndBlockNumber)
+		}
+	}
+
+	if blockTimestampColumn != "" {
+		// start timestamp
+		if options.StartTimestamp != 0 {
+			db = db.Where(blockTimestampColumn+" >= ?", options.StartTimestamp)
+		}
+
+		// end timestamp
+		if options.EndTimestamp != 0 {
+			db = db.Where(blockTimestampColumn+" <= ?", options.EndTimestamp)
+		}
+	}
+
+	return db
+}
+
+// streamRows - scan every row of a query into row and call fn
+// NOTE rows are read from the open cursor one at a time, memory stays constant
+func streamRows(db *gorm.DB, row interface{}, fn func() error) error {
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = db.ScanRows(rows, row)
+		if err != nil {
+			return err
+		}
+
+		err = fn()
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// countCapped - count the rows of a query up to maxCount
+// NOTE counts reaching maxCount are estimated from the query plan
+// Returns: count, is estimated, error (if present)
+func countCapped(db *gorm.DB, maxCount int64) (int64, bool, error) {
+
+	// Capped count
+	cappedDB := db.Session(&gorm.Session{}).Select("1").Limit(int(maxCount))
+
+	count := int64(0)
+	err := db.Session(&gorm.Session{NewDB: true}).Table("(?) AS capped", cappedDB).Count(&count).Error
+	if err != nil {
+		return 0, false, err
+	}
+	if count < maxCount {
+		return count, false, nil
+	}
+
+	// Estimate
+	statement := db.Session(&gorm.Session{DryRun: true}).Select("1").Find(&[]map[string]interface{}{}).Statement
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return 0, false, err
+	}
+
+	plan := ""
+	err = sqlDB.QueryRow("EXPLAIN (FORMAT JSON) "+statement.SQL.String(), statement.Vars...).Scan(&plan)
+	if err != nil {
+		return 0, false, err
+	}
+
+	explain := []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}{}
+	err = json.Unmarshal([]byte(plan), &explain)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(explain) == 0 {
+		return 0, false, errors.New("empty query plan")
+	}
+
+	// NOTE the planner may underestimate, the capped count is a lower bound
+	estimate := int64(explain[0].Plan.PlanRows)
+	if estimate < maxCount {
+		estimate = maxCount
+	}
+
+	return estimate, true, nil
+}
@@ -7,11 +7,13 @@ require (
 	github.com/Shopify/sarama v1.29.1
 	github.com/arsmn/fiber-swagger/v2 v2.13.0
 	github.com/cenkalti/backoff/v4 v4.1.1
+	github.com/fasthttp/websocket v0.0.0-20200320073529-1554a54587ab
 	github.com/frankban/quicktest v1.13.0 // indirect
 	github.com/go-redis/redis/v8 v8.11.3
 	github.com/gofiber/fiber/v2 v2.14.0
 	github.com/gofiber/websocket/v2 v2.0.7
 	github.com/golang/protobuf v1.5.2
+	github.com/graphql-go/graphql v0.8.1
 	github.com/infobloxopen/atlas-app-toolkit v0.24.1-0.20210416193901-4c7518b07e08
 	github.com/infobloxopen/protoc-gen-gorm v0.21.0
 	github.com/jinzhu/gorm v1.9.16
@@ -20,10 +22,12 @@ require (
 	github.com/prometheus/client_golang v1.11.0
 	github.com/sirupsen/logrus v1.8.1
 	github.com/stretchr/testify v1.7.0
+	github.com/valyala/fasthttp v1.26.0
 	go.uber.org/zap v1.18.1
 	golang.org/x/net v0.0.0-20210726213435-c6fcb2dbf985 // indirect
 	golang.org/x/sys v0.0.0-20210630005230-0f9fa26af87c // indirect
 	google.golang.org/genproto v0.0.0-20210726200206-e7812ac95cc0
+	google.golang.org/grpc v1.39.0
 	google.golang.org/protobuf v1.27.1
 	gorm.io/driver/postgres v1.1.0
 	gorm.io/gorm v1.21.12
@@ -27,6 +27,26 @@ var (
 		Help:        "max block number read from the logs_raw topic",
 		ConstLabels: prometheus.Labels{"network_name": config.Config.NetworkName},
 	})
+	TokenHolderBalanceMismatchCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "token_holder_balance_mismatches",
+		Help:        "token holder balances that did not match the node and were repaired",
+		ConstLabels: prometheus.Labels{"network_name": config.Config.NetworkName},
+	})
+	RoutineProcessedGaugeVec = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "routine_processed",
+		Help:        "rows or blocks processed by the current run of a routine",
+		ConstLabels: prometheus.Labels{"network_name": config.Config.NetworkName},
+	}, []string{"routine"})
+	RoutineBlockNumberGaugeVec = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "routine_block_number",
+		Help:        "last block number processed by a routine",
+		ConstLabels: prometheus.Labels{"network_name": config.Config.NetworkName},
+	}, []string{"routine"})
+	RoutineTipBlockNumberGaugeVec = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "routine_tip_block_number",
+		Help:        "chain tip block number a routine runs to",
+		ConstLabels: prometheus.Labels{"network_name": config.Config.NetworkName},
+	}, []string{"routine"})
 )
 
 func Start() {
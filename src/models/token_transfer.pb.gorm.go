@@ -22,7 +22,7 @@ var _ = math.Inf
 
 type TokenTransferORM struct {
 	BlockNumber          uint64 `gorm:"index:token_transfer_idx_block_number"`
-	BlockTimestamp       uint64
+	BlockTimestamp       uint64 `gorm:"index:token_transfer_idx_block_timestamp"`
 	FromAddress          string `gorm:"index:token_transfer_idx_from_address"`
 	LogIndex             int32  `gorm:"primary_key"`
 	ToAddress            string `gorm:"index:token_transfer_idx_to_address"`
@@ -238,7 +238,7 @@ func DefaultListTokenTransfer(ctx context.Context, db *gorm1.DB) ([]*TokenTransf
 		}
 	}
 	db = db.Where(&ormObj)
-	db = db.Order("transaction_hash")
+	db = db.Order("log_index")
 	ormResponse := []TokenTransferORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -23,7 +23,7 @@ var _ = math.Inf
 type TransactionORM struct {
 	BlockHash                 string
 	BlockNumber               uint64 `gorm:"index:transaction_idx_block_number"`
-	BlockTimestamp            uint64
+	BlockTimestamp            uint64 `gorm:"index:transaction_idx_block_timestamp"`
 	Data                      string
 	DataType                  string
 	FromAddress               string `gorm:"index:transaction_idx_from_address"`
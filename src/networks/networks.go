@@ -0,0 +1,217 @@
+package networks
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// Network - network of NETWORKS, served by a child process
+type Network struct {
+	Name        string
+	Port        string
+	HealthPort  string
+	MetricsPort string
+	GRPCPort    string
+}
+
+// Enabled - true if NETWORKS is set
+// NOTE the process then only starts and supervises a child process per network
+func Enabled() bool {
+	return len(GetNetworks()) > 0
+}
+
+// GetNetworks - networks of NETWORKS, in order
+// NOTE ports of the network at index i are the configured ports plus i + 1
+func GetNetworks() []*Network {
+	networks := []*Network{}
+
+	for _, name := range config.Config.Networks {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		offset := len(networks) + 1
+		networks = append(networks, &Network{
+			Name:        name,
+			Port:        offsetPort(config.Config.Port, offset),
+			HealthPort:  offsetPort(config.Config.HealthPort, offset),
+			MetricsPort: offsetPort(config.Config.MetricsPort, offset),
+			GRPCPort:    offsetPort(config.Config.GRPCPort, offset),
+		})
+	}
+
+	return networks
+}
+
+// GetNetwork - network by name, nil if not in NETWORKS
+func GetNetwork(name string) *Network {
+	for _, network := range GetNetworks() {
+		if network.Name == name {
+			return network
+		}
+	}
+
+	return nil
+}
+
+func offsetPort(port string, offset int) string {
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		zap.S().Fatal("Networks: invalid port ", port)
+	}
+
+	return strconv.Itoa(p + offset)
+}
+
+// Env - environment of the child process of a network
+// NOTE variables scoped to the network, ex LISBON_DB_SCHEMA, override the isolation defaults
+func (n *Network) Env() []string {
+	prefix := config.NetworkEnvPrefix(n.Name) + "_"
+
+	// NOTE the last value of a duplicate variable is used
+	return append(os.Environ(),
+		// Single network
+		"NETWORKS=",
+		"NETWORK_NAME="+n.Name,
+
+		// Isolation defaults
+		"DB_SCHEMA="+n.Name,
+		"REDIS_KEY_PREFIX="+config.Config.RedisKeyPrefix+n.Name+"_",
+		"REDIS_CHANNEL="+config.Config.RedisChannel+"_"+n.Name,
+		"KAFKA_GROUP_ID="+config.Config.KafkaGroupID+"-"+n.Name,
+		"CONSUMER_GROUP="+config.Config.ConsumerGroup+"-"+n.Name,
+		"LOG_FILE_NAME="+n.Name+"-"+config.Config.LogFileName,
+
+		// Set by the parent process
+		prefix+"PORT="+n.Port,
+		prefix+"HEALTH_PORT="+n.HealthPort,
+		prefix+"METRICS_PORT="+n.MetricsPort,
+		prefix+"GRPC_PORT="+n.GRPCPort,
+		prefix+"REST_PREFIX="+config.Config.RestPrefix+"/"+n.Name,
+		prefix+"WEBSOCKET_PREFIX="+config.Config.WebsocketPrefix+"/"+n.Name,
+		prefix+"REST_PROXY_HEADER=X-Forwarded-For",
+
+		// NOTE only the gateway can set the proxy header
+		prefix+"REST_HOST=127.0.0.1",
+	)
+}
+
+///////////////
+// Processes //
+///////////////
+
+type process struct {
+	network *Network
+	cmd     *exec.Cmd
+	done    chan struct{}
+}
+
+var processes []*process
+var processesStopping bool
+var processesMutex sync.Mutex
+
+// StartProcesses - start this binary for each network
+// NOTE if a network exits all networks are stopped and the parent exits
+func StartProcesses() {
+	executable, err := os.Executable()
+	if err != nil {
+		zap.S().Fatal("Networks: unable to find executable: ", err.Error())
+	}
+
+	for _, network := range GetNetworks() {
+		cmd := exec.Command(executable, os.Args[1:]...)
+		cmd.Env = network.Env()
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		err := cmd.Start()
+		if err != nil {
+			StopProcesses()
+			zap.S().Fatal("Networks: unable to start network ", network.Name, ": ", err.Error())
+		}
+		zap.S().Info("Networks: started network ", network.Name, " pid=", cmd.Process.Pid, " port=", network.Port)
+
+		p := &process{
+			network: network,
+			cmd:     cmd,
+			done:    make(chan struct{}),
+		}
+
+		processesMutex.Lock()
+		processes = append(processes, p)
+		processesMutex.Unlock()
+
+		go waitProcess(p)
+	}
+}
+
+// RunProcesses - run this binary for each network, one at a time
+// NOTE used by commands, ex migrate
+func RunProcesses() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	for _, network := range GetNetworks() {
+		cmd := exec.Command(executable, os.Args[1:]...)
+		cmd.Env = network.Env()
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		err = cmd.Run()
+		if err != nil {
+			return errors.New("network " + network.Name + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func waitProcess(p *process) {
+	err := p.cmd.Wait()
+	close(p.done)
+
+	processesMutex.Lock()
+	stopping := processesStopping
+	processesMutex.Unlock()
+	if stopping {
+		return
+	}
+
+	// Unexpected exit
+	StopProcesses()
+	if err != nil {
+		zap.S().Fatal("Networks: network ", p.network.Name, " exited: ", err.Error())
+	}
+	zap.S().Fatal("Networks: network ", p.network.Name, " exited")
+}
+
+// StopProcesses - stop the networks and wait for them to exit
+func StopProcesses() {
+	processesMutex.Lock()
+	processesStopping = true
+	stopped := processes
+	processesMutex.Unlock()
+
+	for _, p := range stopped {
+		err := p.cmd.Process.Signal(syscall.SIGTERM)
+		if err != nil {
+			zap.S().Warn("Networks: unable to stop network ", p.network.Name, ": ", err.Error())
+		}
+	}
+
+	for _, p := range stopped {
+		<-p.done
+	}
+}
@@ -0,0 +1,60 @@
+//+build unit
+
+package networks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+func init() {
+	config.ReadEnvironment()
+}
+
+func TestGetNetworks(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.Networks = nil
+	assert.Equal(false, Enabled())
+	assert.Equal(0, len(GetNetworks()))
+
+	config.Config.Networks = []string{"mainnet", " lisbon", ""}
+	config.Config.Port = "8000"
+	config.Config.GRPCPort = "50051"
+	defer func() { config.Config.Networks = nil }()
+
+	networks := GetNetworks()
+	assert.Equal(true, Enabled())
+	assert.Equal(2, len(networks))
+	assert.Equal("mainnet", networks[0].Name)
+	assert.Equal("8001", networks[0].Port)
+	assert.Equal("50052", networks[0].GRPCPort)
+	assert.Equal("lisbon", networks[1].Name)
+	assert.Equal("8002", networks[1].Port)
+	assert.Equal("50053", networks[1].GRPCPort)
+
+	assert.Equal("lisbon", GetNetwork("lisbon").Name)
+	assert.Equal((*Network)(nil), GetNetwork("berlin"))
+}
+
+func TestNetworkEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	config.Config.Networks = []string{"mainnet", "lisbon"}
+	config.Config.RestPrefix = "/api/v1"
+	config.Config.RedisKeyPrefix = "icon_transactions_"
+	defer func() { config.Config.Networks = nil }()
+
+	env := GetNetwork("lisbon").Env()
+
+	assert.Contains(env, "NETWORKS=")
+	assert.Contains(env, "NETWORK_NAME=lisbon")
+	assert.Contains(env, "DB_SCHEMA=lisbon")
+	assert.Contains(env, "REDIS_KEY_PREFIX=icon_transactions_lisbon_")
+	assert.Contains(env, "LISBON_PORT="+GetNetwork("lisbon").Port)
+	assert.Contains(env, "LISBON_REST_PREFIX=/api/v1/lisbon")
+	assert.Contains(env, "LISBON_REST_HOST=127.0.0.1")
+}
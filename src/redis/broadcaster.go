@@ -62,7 +62,9 @@ func (b *Broadcaster) Start() {
 				select {
 				case channel <- msg:
 				case <-time.After(time.Second * 1):
+					// NOTE closed so the reader knows it was dropped
 					b.RemoveBroadcastChannel(id)
+					close(channel)
 				}
 			}
 		}
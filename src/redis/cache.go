@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// GetCacheValue - value of a cache key, "" if missing or expired
+func (c *Client) GetCacheValue(key string) (string, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	value, err := c.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	return value, err
+}
+
+// SetCacheValue - set a cache key, removed after expiration
+func (c *Client) SetCacheValue(key string, value string, expiration time.Duration) error {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	err := c.client.Set(ctx, key, value, expiration).Err()
+
+	return err
+}
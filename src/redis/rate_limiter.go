@@ -0,0 +1,93 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Token bucket, refilled by the time since the last request
+// KEYS[1] = bucket, ARGV = rate per second, burst, now in micro seconds
+// Returns: allowed (1 or 0), tokens left
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "timestamp")
+local tokens = tonumber(bucket[1])
+local timestamp = tonumber(bucket[2])
+if tokens == nil or timestamp == nil then
+	tokens = burst
+	timestamp = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - timestamp) / 1000000 * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "timestamp", now)
+redis.call("EXPIRE", KEYS[1], math.ceil(burst / rate) + 1)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RateLimit - state of a bucket after a request
+type RateLimit struct {
+	Allowed    bool
+	Remaining  int64
+	RetryAfter time.Duration // until the next token, 0 if allowed
+	Reset      time.Duration // until the bucket is full
+}
+
+// TakeToken - take a token from the bucket at key
+// NOTE buckets hold burst tokens and refill at rate tokens per second
+func (c *Client) TakeToken(key string, rate float64, burst int64) (*RateLimit, error) {
+
+	result, err := takeTokenScript.Run(
+		context.Background(),
+		c.client,
+		[]string{key},
+		rate,
+		burst,
+		time.Now().UnixNano()/1000,
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values, _ := result.([]interface{})
+	if len(values) != 2 {
+		return nil, errors.New("RateLimiter: Unexpected script result")
+	}
+	allowed, _ := values[0].(int64)
+	tokensStr, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRateLimit(allowed == 1, tokens, rate, burst), nil
+}
+
+func newRateLimit(allowed bool, tokens float64, rate float64, burst int64) *RateLimit {
+	rateLimit := &RateLimit{
+		Allowed:   allowed,
+		Remaining: int64(math.Floor(tokens)),
+		Reset:     time.Duration((float64(burst) - tokens) / rate * float64(time.Second)),
+	}
+
+	if allowed == false {
+		rateLimit.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+
+	return rateLimit
+}
@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/geometry-labs/icon-transactions/config"
+)
+
+// Latest block number loaded by the worker
+// NOTE caches of the api are keyed on the tip, new blocks invalidate them
+func tipBlockNumberKey() string {
+	return config.Config.RedisKeyPrefix + "tip_block_number"
+}
+
+// Set KEYS[1] to ARGV[1] if it is greater than the current value
+var setMaxScript = redis.NewScript(`
+local current = tonumber(redis.call("GET", KEYS[1]))
+if current == nil or tonumber(ARGV[1]) > current then
+	redis.call("SET", KEYS[1], ARGV[1])
+end
+
+return 1
+`)
+
+// Latest block number loaded by the token transfer loader
+// NOTE token transfers are loaded from logs, apart from the transactions
+func tokenTransferTipBlockNumberKey() string {
+	return config.Config.RedisKeyPrefix + "token_transfer_tip_block_number"
+}
+
+// SetTipBlockNumber - set the tip if blockNumber is greater than the current tip
+// NOTE loaders can run out of order, the tip never moves back
+func (c *Client) SetTipBlockNumber(blockNumber uint64) error {
+	return c.setMax(tipBlockNumberKey(), blockNumber)
+}
+
+// GetTipBlockNumber - latest block number loaded, 0 if none
+func (c *Client) GetTipBlockNumber() (uint64, error) {
+	return c.getUint64(tipBlockNumberKey())
+}
+
+// SetTokenTransferTipBlockNumber - set the token transfer tip if blockNumber is greater than the current one
+func (c *Client) SetTokenTransferTipBlockNumber(blockNumber uint64) error {
+	return c.setMax(tokenTransferTipBlockNumberKey(), blockNumber)
+}
+
+// GetTokenTransferTipBlockNumber - latest block number of a token transfer loaded, 0 if none
+func (c *Client) GetTokenTransferTipBlockNumber() (uint64, error) {
+	return c.getUint64(tokenTransferTipBlockNumberKey())
+}
+
+func (c *Client) setMax(key string, value uint64) error {
+
+	err := setMaxScript.Run(
+		context.Background(),
+		c.client,
+		[]string{key},
+		value,
+	).Err()
+
+	return err
+}
+
+func (c *Client) getUint64(key string) (uint64, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	value, err := c.client.Get(ctx, key).Uint64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	return value, err
+}
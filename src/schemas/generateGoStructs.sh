@@ -4,7 +4,7 @@ rm ../models/*
 
 echo "Starting proto to struct..."
 
-protoc -I=. -I=$GOPATH/src/ --go_out=.. --gorm_out=engine=postgres:.. *.proto
+protoc -I=. -I=$GOPATH/src/ --go_out=.. --go-grpc_out=.. --gorm_out=engine=postgres:.. *.proto
 
 # Remove omitempty option
 # Credit: https://stackoverflow.com/a/37335452
@@ -2,12 +2,17 @@ package main
 
 import (
 	"log"
+	"os"
+
+	"go.uber.org/zap"
 
 	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/global"
 	"github.com/geometry-labs/icon-transactions/kafka"
 	"github.com/geometry-labs/icon-transactions/logging"
 	"github.com/geometry-labs/icon-transactions/metrics"
+	"github.com/geometry-labs/icon-transactions/networks"
 	"github.com/geometry-labs/icon-transactions/worker/backfills"
 	"github.com/geometry-labs/icon-transactions/worker/routines"
 	"github.com/geometry-labs/icon-transactions/worker/transformers"
@@ -19,9 +24,47 @@ func main() {
 	logging.Init()
 	log.Printf("Main: Starting logging with level %s", config.Config.LogLevel)
 
+	// Migrate command
+	// NOTE `main migrate [up [steps] | down [steps] | status]` migrates the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		var err error
+		if networks.Enabled() {
+			// Schema of each network
+			err = networks.RunProcesses()
+		} else {
+			err = crud.RunMigrateCommand(os.Args[2:])
+		}
+		if err != nil {
+			zap.S().Fatal("Main: ", err.Error())
+		}
+		return
+	}
+
+	// Networks
+	// NOTE each network is indexed by a child process
+	if networks.Enabled() {
+		networks.StartProcesses()
+
+		global.WaitShutdownSig()
+		networks.StopProcesses()
+		return
+	}
+
+	// NOTE the worker reads its own writes, replicas are for the api
+	config.Config.DbReadHosts = nil
+
+	// Schema
+	err := crud.CheckMigrations()
+	if err != nil {
+		zap.S().Fatal("Main: ", err.Error())
+	}
+
 	// Start Prometheus client
 	metrics.Start()
 
+	// Partitions
+	routines.StartPartitionsRoutine()
+
 	// Feature flags
 	if config.Config.OnlyRunAllRoutines == true {
 		// Start routines
@@ -32,6 +75,10 @@ func main() {
 		routines.StartTokenTransferCountByTokenContractRoutine()
 		routines.StartTokenHoldersRoutine()
 		routines.StartTokenHolderCountByTokenContractRoutine()
+		routines.StartTokenHolderCheckpointsRoutine()
+		routines.StartHolderDistributionsRoutine()
+		routines.StartIcxBalancesRoutine()
+		routines.StartTransactionMissingRoutine()
 
 		global.WaitShutdownSig()
 	} else if config.Config.OnlyRunBackfill {
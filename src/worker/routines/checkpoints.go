@@ -0,0 +1,33 @@
+package routines
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/metrics"
+)
+
+// NOTE routines save a cursor, a block number or the last key processed, after each page
+// A run stopped by a crash or a restart resumes from it, a completed run saves an empty cursor
+
+// loadCheckpoint - cursor to resume a routine from, empty to start over
+func loadCheckpoint(name string) (string, error) {
+	cursor, err := crud.GetRoutineCheckpointModel().SelectCursor(name)
+	if err != nil {
+		return "", err
+	}
+
+	if cursor != "" {
+		zap.S().Info("Routine=", name, " - Resuming from ", cursor)
+	}
+	metrics.RoutineProcessedGaugeVec.WithLabelValues(name).Set(0)
+
+	return cursor, nil
+}
+
+// saveCheckpoint - persist the cursor of a routine after processed rows or blocks
+func saveCheckpoint(name string, cursor string, processed int) error {
+	metrics.RoutineProcessedGaugeVec.WithLabelValues(name).Add(float64(processed))
+
+	return crud.GetRoutineCheckpointModel().UpsertCursor(name, cursor)
+}
@@ -0,0 +1,85 @@
+package routines
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
+	"github.com/geometry-labs/icon-transactions/worker/utils"
+)
+
+const holderDistributionsRoutineName = "holder_distributions"
+
+func StartHolderDistributionsRoutine() {
+
+	// routine on schedule, ROUTINE_HOLDER_DISTRIBUTIONS_SCHEDULE
+	scheduler.Start(holderDistributionsRoutineName, config.Config.RoutineHolderDistributionsSchedule, holderDistributionsRoutine)
+}
+
+func holderDistributionsRoutine() error {
+
+	// Resume from the last token contract processed
+	tokenContractAddress, err := loadCheckpoint(holderDistributionsRoutineName)
+	if err != nil {
+		return err
+	}
+
+	// Loop through all token contracts
+	limit := 100
+	for {
+		tokenTransfers, err := crud.GetTokenTransferModel().SelectManyDistinctTokenContractsAfter(limit, tokenContractAddress)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*tokenTransfers) == 0 {
+			// Done
+			break
+		}
+
+		zap.S().Info("Routine=HolderDistributions", " - Processing ", len(*tokenTransfers), " token contracts...")
+		for _, t := range *tokenTransfers {
+
+			// Node calls
+			totalSupply, err := utils.IconNodeServiceGetTokenTotalSupply(t.TokenContractAddress)
+			if err != nil {
+				// Icon node error
+				zap.S().Warn("Routine=HolderDistributions - Error: ", err.Error())
+				continue
+			}
+			decimalBase, err := utils.IconNodeServiceGetTokenDecimalBase(t.TokenContractAddress)
+			if err != nil {
+				// Icon node error
+				zap.S().Warn("Routine=HolderDistributions - Error: ", err.Error())
+				continue
+			}
+
+			err = crud.GetHolderDistributionModel().BuildTokenDistribution(
+				t.TokenContractAddress,
+				totalSupply,
+				decimalBase,
+			)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn("Routine=HolderDistributions - Error: ", err.Error())
+				continue
+			}
+		}
+
+		// Checkpoint
+		tokenContractAddress = (*tokenTransfers)[len(*tokenTransfers)-1].TokenContractAddress
+		err = saveCheckpoint(holderDistributionsRoutineName, tokenContractAddress, len(*tokenTransfers))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Completed, the next run starts over
+	return saveCheckpoint(holderDistributionsRoutineName, "", 0)
+}
@@ -0,0 +1,97 @@
+package routines
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
+	"github.com/geometry-labs/icon-transactions/worker/utils"
+)
+
+const icxBalancesRoutineName = "icx_balances"
+
+func StartIcxBalancesRoutine() {
+
+	// routine on schedule, ROUTINE_ICX_BALANCES_SCHEDULE
+	scheduler.Start(icxBalancesRoutineName, config.Config.RoutineIcxBalancesSchedule, icxBalancesRoutine)
+}
+
+func icxBalancesRoutine() error {
+
+	// Resume from the last address processed
+	address, err := loadCheckpoint(icxBalancesRoutineName)
+	if err != nil {
+		return err
+	}
+
+	// Loop through all addresses
+	limit := 1000
+	for {
+		transactionCountByAddresses, err := crud.GetTransactionCountByAddressModel().SelectManyAfterAddress(limit, address)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*transactionCountByAddresses) == 0 {
+			// Done
+			break
+		}
+
+		zap.S().Info("Routine=IcxBalances", " - Processing ", len(*transactionCountByAddresses), " addresses...")
+		for _, t := range *transactionCountByAddresses {
+
+			// Node call
+			value, err := utils.IconNodeServiceGetBalance(t.Address)
+			if err != nil {
+				// Icon node error
+				zap.S().Warn("Routine=IcxBalances - Error: ", err.Error())
+				continue
+			}
+
+			icxBalance := &models.IcxBalance{
+				Address:          t.Address,
+				Value:            value,
+				ValueDecimal:     utils.StringHexToFloat64(value, 18),
+				UpdatedTimestamp: uint64(time.Now().UnixNano() / 1000),
+			}
+
+			err = crud.GetIcxBalanceModel().UpsertOne(icxBalance)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn("Routine=IcxBalances - Error: ", err.Error())
+				continue
+			}
+		}
+
+		// Checkpoint
+		address = (*transactionCountByAddresses)[len(*transactionCountByAddresses)-1].Address
+		err = saveCheckpoint(icxBalancesRoutineName, address, len(*transactionCountByAddresses))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Rich list distribution
+	totalSupply, err := utils.IconNodeServiceGetTotalSupply()
+	if err != nil {
+		// Icon node error
+		zap.S().Warn("Routine=IcxBalances - Error: ", err.Error())
+	} else {
+		err = crud.GetHolderDistributionModel().BuildIcxDistribution(totalSupply)
+		if err != nil {
+			// Postgres error
+			zap.S().Warn("Routine=IcxBalances - Error: ", err.Error())
+		}
+	}
+
+	// Completed, the next run starts over
+	return saveCheckpoint(icxBalancesRoutineName, "", 0)
+}
@@ -0,0 +1,52 @@
+package routines
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/worker/utils"
+)
+
+func StartPartitionsRoutine() {
+	if config.Config.DbPartitionSize == 0 {
+		return
+	}
+
+	// Partitions past the tip
+	// NOTE created before the loaders start
+	err := crud.CreatePartitions(partitionsTipBlockNumber())
+	if err != nil {
+		zap.S().Fatal("Routine=Partitions - Error: ", err.Error())
+	}
+
+	go partitionsRoutine(time.Duration(config.Config.DbPartitionCheckSeconds) * time.Second)
+}
+
+func partitionsRoutine(duration time.Duration) {
+
+	// Loop every duration
+	for {
+
+		// NOTE tables not partitioned yet are partitioned online
+		err := crud.PartitionTables(partitionsTipBlockNumber())
+		if err != nil {
+			zap.S().Warn("Routine=Partitions - Error: ", err.Error())
+		}
+
+		time.Sleep(duration)
+	}
+}
+
+// partitionsTipBlockNumber - last block of the chain, 0 if unknown
+func partitionsTipBlockNumber() uint64 {
+	height, err := utils.IconNodeServiceGetLastBlockHeight()
+	if err != nil {
+		zap.S().Warn("Routine=Partitions - Cannot get last block: ", err.Error())
+		return 0
+	}
+
+	return uint64(height)
+}
@@ -0,0 +1,74 @@
+package routines
+
+import (
+	"errors"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/geometry-labs/icon-transactions/config"
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
+)
+
+// NOTE checkpoints store the token holder balances every TOKEN_HOLDER_CHECKPOINT_INTERVAL blocks
+// Balances at a block are read from the nearest checkpoint plus the token transfers after it
+func StartTokenHolderCheckpointsRoutine() {
+
+	// routine on schedule, ROUTINE_TOKEN_HOLDER_CHECKPOINTS_SCHEDULE
+	scheduler.Start("token_holder_checkpoints", config.Config.RoutineTokenHolderCheckpointsSchedule, tokenHolderCheckpointsRoutine)
+}
+
+func tokenHolderCheckpointsRoutine() error {
+
+	interval := config.Config.TokenHolderCheckpointInterval
+
+	// Latest indexed block
+	maxBlockNumber, err := crud.GetTokenTransferModel().SelectMaxBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	// Contiguous completeness watermark
+	// NOTE blocks up to the transaction missing cursor were checked against the node
+	cursor, err := crud.GetRoutineCheckpointModel().SelectCursor(transactionMissingRoutineName)
+	if err != nil {
+		return err
+	}
+	if cursor == "" {
+		zap.S().Info("Routine=TokenHolderCheckpoints - No blocks checked by the transaction missing routine, skipping...")
+		return nil
+	}
+	checkedBlockNumber, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return errors.New("Invalid transaction missing checkpoint " + cursor)
+	}
+	if checkedBlockNumber < maxBlockNumber {
+		maxBlockNumber = checkedBlockNumber
+	}
+
+	// Latest checkpoint
+	blockNumber, err := crud.GetTokenHolderCheckpointModel().SelectLatestBlockNumber(0)
+	if err != nil {
+		return err
+	}
+
+	// Build every completed interval
+	// NOTE the chain tip is left alone, transfers may still be loading
+	// Transfers loaded late at or below a checkpoint delete it, it is rebuilt on the next run
+	for interval != 0 && blockNumber+interval < maxBlockNumber {
+		nextBlockNumber := blockNumber + interval
+
+		err = crud.GetTokenHolderCheckpointModel().BuildCheckpoint(blockNumber, nextBlockNumber)
+		if err != nil {
+			// Postgres error
+			zap.S().Warn("Routine=TokenHolderCheckpoints, BlockNumber=", nextBlockNumber, " - Error: ", err.Error())
+			return err
+		}
+
+		zap.S().Info("Routine=TokenHolderCheckpoints, BlockNumber=", nextBlockNumber, " - Checkpoint built")
+		blockNumber = nextBlockNumber
+	}
+
+	return nil
+}
@@ -2,91 +2,189 @@ package routines
 
 import (
 	"errors"
-	"time"
+	"strings"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
-	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/metrics"
+	"github.com/geometry-labs/icon-transactions/redis"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 	"github.com/geometry-labs/icon-transactions/worker/utils"
 )
 
+const tokenHoldersRoutineName = "token_holders"
+
+// NOTE token holder balances are kept up to date by the token transfer loader
+// This routine reconciles the stored balances against the node and repairs mismatches
 func StartTokenHoldersRoutine() {
 
-	// routine every day
-	go tokenHoldersRoutine(3600*time.Second, true)
-	go tokenHoldersRoutine(3600*time.Second, false)
+	// routine on schedule, ROUTINE_TOKEN_HOLDERS_SCHEDULE
+	scheduler.Start(tokenHoldersRoutineName, config.Config.RoutineTokenHoldersSchedule, tokenHoldersRoutine)
 }
 
-func tokenHoldersRoutine(duration time.Duration, isFromAddress bool) {
+func tokenHoldersRoutine() error {
 
-	// Loop every duration
+	// Resume from the last token holder processed
+	// NOTE the cursor is <token contract address>:<holder address>
+	cursor, err := loadCheckpoint(tokenHoldersRoutineName)
+	if err != nil {
+		return err
+	}
+	tokenContractAddress, holderAddress := "", ""
+	if cursor != "" {
+		tokenContractAddress, holderAddress = splitTokenHolderCursor(cursor)
+	}
+
+	// Loop through all token holders
+	limit := 1000
+	mismatches := 0
 	for {
+		tokenHolders, err := crud.GetTokenHolderModel().SelectManyAfterPrimaryKey(limit, tokenContractAddress, holderAddress)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*tokenHolders) == 0 {
+			// Done
+			break
+		}
+
+		// Balances are compared at the last block of token transfers loaded
+		// NOTE node balances past it include transfers not loaded yet
+		loadedBlockNumber, isCaughtUp, err := tokenHoldersLoadedBlockNumber()
+		if err != nil {
+			return err
+		}
+		if isCaughtUp == false {
+			// Resumes from the checkpoint on the next run
+			zap.S().Info("Routine=TokenHolders", " LoadedBlockNumber=", loadedBlockNumber, " - Loader behind the node, skipping...")
+			return nil
+		}
 
-		// Loop through all addresses
-		skip := 0
-		limit := 1000
-		for {
-			var tokenTransfers *[]models.TokenTransfer
-			var err error
-			if isFromAddress {
-				tokenTransfers, err = crud.GetTokenTransferModel().SelectManyDistinctFromAddresses(limit, skip)
-			} else {
-				tokenTransfers, err = crud.GetTokenTransferModel().SelectManyDistinctToAddresses(limit, skip)
+		zap.S().Info("Routine=TokenHolders", " - Reconciling ", len(*tokenHolders), " token holders...")
+		for i := range *tokenHolders {
+			t := &(*tokenHolders)[i]
+
+			// Node call
+			value, err := utils.IconNodeServiceGetTokenBalance(t.TokenContractAddress, t.HolderAddress, loadedBlockNumber)
+			if err != nil {
+				// Icon node error
+				zap.S().Warn("Routine=TokenHolders - Error: ", err.Error())
+				continue
+			}
+
+			if utils.StringHexEqual(value, t.Value) {
+				// Balance matches
+				continue
+			}
+
+			// Transfers loaded past the block are in the stored balance only
+			isChanged, err := crud.GetTokenTransferModel().ExistsByHolderAfterBlock(
+				t.TokenContractAddress,
+				t.HolderAddress,
+				loadedBlockNumber,
+			)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn("Routine=TokenHolders - Error: ", err.Error())
+				continue
 			}
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				// Sleep
-				break
-			} else if err != nil {
-				zap.S().Fatal(err.Error())
+			if isChanged == true {
+				continue
 			}
-			if len(*tokenTransfers) == 0 {
-				// Sleep
-				break
+
+			// Hex -> float64
+			decimalBase, err := utils.IconNodeServiceGetTokenDecimalBase(t.TokenContractAddress)
+			if err != nil {
+				// Icon node error
+				zap.S().Warn("Routine=TokenHolders - Error: ", err.Error())
+				continue
 			}
+			valueDecimal := utils.StringHexToFloat64(value, decimalBase)
 
-			zap.S().Info("Routine=TokenHolders", " - Processing ", len(*tokenTransfers), " token transfers...")
-			for _, t := range *tokenTransfers {
-				address := ""
-				if isFromAddress {
-					address = t.FromAddress
-				} else {
-					address = t.ToAddress
-				}
-
-				// Node call
-				value, err := utils.IconNodeServiceGetTokenBalance(t.TokenContractAddress, address)
-				if err != nil {
-					// Icon node error
-					zap.S().Warn("Routine=TokenHolder - Error: ", err.Error())
-					continue
-				}
-
-				// Hex -> float64
-				decimalBase, err := utils.IconNodeServiceGetTokenDecimalBase(t.TokenContractAddress)
-				if err != nil {
-					// Icon node error
-					zap.S().Warn("Routine=TokenHolder - Error: ", err.Error())
-					continue
-				}
-				valueDecimal := utils.StringHexToFloat64(value, decimalBase)
-
-				tokenHolder := &models.TokenHolder{
-					TokenContractAddress: t.TokenContractAddress,
-					HolderAddress:        address,
-					Value:                value,
-					ValueDecimal:         valueDecimal,
-				}
-
-				// Insert to database
-				crud.GetTokenHolderModel().LoaderChannel <- tokenHolder
+			// Repair
+			// NOTE skipped if the loader changed the balance since it was read
+			isUpdated, err := crud.GetTokenHolderModel().UpdateValueIfUnchanged(
+				t.TokenContractAddress,
+				t.HolderAddress,
+				t.Value,
+				value,
+				valueDecimal,
+			)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn("Routine=TokenHolders - Error: ", err.Error())
+				continue
+			}
+			if isUpdated == false {
+				continue
 			}
 
-			skip += limit
+			zap.S().Warn(
+				"Routine=TokenHolders",
+				" TokenContractAddress=", t.TokenContractAddress,
+				" HolderAddress=", t.HolderAddress,
+				" StoredValue=", t.Value,
+				" NodeValue=", value,
+				" - Balance mismatch repaired",
+			)
+			metrics.TokenHolderBalanceMismatchCounter.Inc()
+			mismatches++
 		}
 
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+		// Checkpoint
+		last := &(*tokenHolders)[len(*tokenHolders)-1]
+		tokenContractAddress, holderAddress = last.TokenContractAddress, last.HolderAddress
+		err = saveCheckpoint(tokenHoldersRoutineName, tokenContractAddress+":"+holderAddress, len(*tokenHolders))
+		if err != nil {
+			return err
+		}
 	}
+
+	zap.S().Info("Routine=TokenHolders - Repaired ", mismatches, " balance mismatches")
+
+	// Completed, the next run starts over
+	return saveCheckpoint(tokenHoldersRoutineName, "", 0)
+}
+
+// tokenHoldersLoadedBlockNumber - last block with all its token transfers loaded, and if the loaders are within TOKEN_HOLDERS_MAX_LAG_BLOCKS of the node
+// NOTE token transfers are loaded apart from the transactions, the block of the last transfer loaded may be partly loaded
+func tokenHoldersLoadedBlockNumber() (uint64, bool, error) {
+	tokenTransferBlockNumber, err := redis.GetRedisClient().GetTokenTransferTipBlockNumber()
+	if err != nil {
+		return 0, false, err
+	}
+	if tokenTransferBlockNumber <= 1 {
+		return 0, false, nil
+	}
+	loadedBlockNumber := tokenTransferBlockNumber - 1
+
+	tipBlockNumber, err := redis.GetRedisClient().GetTipBlockNumber()
+	if err != nil {
+		return 0, false, err
+	}
+
+	nodeBlockNumber, err := utils.IconNodeServiceGetLastBlockHeight()
+	if err != nil {
+		return 0, false, err
+	}
+
+	isCaughtUp := uint64(nodeBlockNumber) <= tipBlockNumber+config.Config.TokenHoldersMaxLagBlocks
+
+	return loadedBlockNumber, isCaughtUp, nil
+}
+
+// splitTokenHolderCursor - token contract address and holder address of a token holders cursor
+func splitTokenHolderCursor(cursor string) (string, string) {
+	addresses := strings.SplitN(cursor, ":", 2)
+	if len(addresses) != 2 {
+		return addresses[0], ""
+	}
+
+	return addresses[0], addresses[1]
 }
@@ -2,64 +2,60 @@ package routines
 
 import (
 	"errors"
-	"time"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 )
 
 func StartTokenHolderCountByTokenContractRoutine() {
 
-	// routine every day
-	go tokenHolderCountByTokenContractRoutine(3600 * time.Second)
+	// routine on schedule, ROUTINE_TOKEN_HOLDER_COUNT_BY_TOKEN_CONTRACT_SCHEDULE
+	scheduler.Start("token_holder_count_by_token_contract", config.Config.RoutineTokenHolderCountByTokenContractSchedule, tokenHolderCountByTokenContractRoutine)
 }
 
-func tokenHolderCountByTokenContractRoutine(duration time.Duration) {
+func tokenHolderCountByTokenContractRoutine() error {
 
-	// Loop every duration
+	// Loop through all addresses
+	skip := 0
+	limit := 1000
 	for {
+		tokenHolders, err := crud.GetTokenHolderModel().SelectMany(limit, skip)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*tokenHolders) == 0 {
+			// Done
+			break
+		}
 
-		// Loop through all addresses
-		skip := 0
-		limit := 1000
-		for {
-			tokenHolders, err := crud.GetTokenHolderModel().SelectMany(limit, skip)
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				// Sleep
-				break
-			} else if err != nil {
-				zap.S().Fatal(err.Error())
-			}
-			if len(*tokenHolders) == 0 {
-				// Sleep
-				break
-			}
-
-			zap.S().Info("Routine=TokenHolderCountByTokenContract", " - Processing ", len(*tokenHolders), " token holders...")
-			for _, t := range *tokenHolders {
-
-				count, err := crud.GetTokenHolderModel().CountByTokenContract(t.TokenContractAddress)
-				if err != nil {
-					zap.S().Warn("Routine=TokenHolderCountByTokenContract", " - Error counting holders: ", err.Error())
-					continue
-				}
+		zap.S().Info("Routine=TokenHolderCountByTokenContract", " - Processing ", len(*tokenHolders), " token holders...")
+		for _, t := range *tokenHolders {
 
-				tokenHolderCountByTokenContract := &models.TokenHolderCountByTokenContract{
-					TokenContractAddress: t.TokenContractAddress,
-					Count:                uint64(count),
-				}
+			count, err := crud.GetTokenHolderModel().CountByTokenContract(t.TokenContractAddress)
+			if err != nil {
+				zap.S().Warn("Routine=TokenHolderCountByTokenContract", " - Error counting holders: ", err.Error())
+				continue
+			}
 
-				// Insert to database
-				crud.GetTokenHolderCountByTokenContractModel().LoaderChannel <- tokenHolderCountByTokenContract
+			tokenHolderCountByTokenContract := &models.TokenHolderCountByTokenContract{
+				TokenContractAddress: t.TokenContractAddress,
+				Count:                uint64(count),
 			}
 
-			skip += limit
+			// Insert to database
+			crud.GetTokenHolderCountByTokenContractModel().LoaderChannel <- tokenHolderCountByTokenContract
 		}
 
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+		skip += limit
 	}
+
+	return nil
 }
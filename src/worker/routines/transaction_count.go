@@ -1,111 +1,98 @@
 package routines
 
 import (
-	"time"
-
-	"go.uber.org/zap"
-
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 )
 
 func StartTransactionCountRoutine() {
 
-	// routine every day
-	go transactionCountRoutine(3600 * time.Second)
+	// routine on schedule, ROUTINE_TRANSACTION_COUNT_SCHEDULE
+	scheduler.Start("transaction_count", config.Config.RoutineTransactionCountSchedule, transactionCountRoutine)
 }
 
-func transactionCountRoutine(duration time.Duration) {
-
-	// Loop every duration
-	for {
-
-		/////////////
-		// Regular //
-		/////////////
-
-		// Count
-		count, err := crud.GetTransactionModel().CountRegular()
-		if err != nil {
-			// Postgres error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Redis
-		countKey := "icon_transactions_transaction_count_regular"
-		err = redis.GetRedisClient().SetCount(countKey, count)
-		if err != nil {
-			// Redis error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Postgres
-		transactionCount := &models.TransactionCount{
-			Type:  "regular",
-			Count: uint64(count),
-		}
-		err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
-
-		//////////////
-		// Internal //
-		//////////////
-
-		// Count
-		count, err = crud.GetTransactionModel().CountInternal()
-		if err != nil {
-			// Postgres error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Redis
-		countKey = "icon_transactions_transaction_count_internal"
-		err = redis.GetRedisClient().SetCount(countKey, count)
-		if err != nil {
-			// Redis error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Postgres
-		transactionCount = &models.TransactionCount{
-			Type:  "internal",
-			Count: uint64(count),
-		}
-		err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
-
-		////////////////////
-		// Token Transfer //
-		////////////////////
-
-		// Count
-		count, err = crud.GetTokenTransferModel().Count()
-		if err != nil {
-			// Postgres error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Redis
-		countKey = "icon_transactions_transaction_count_token_transfer"
-		err = redis.GetRedisClient().SetCount(countKey, count)
-		if err != nil {
-			// Redis error
-			zap.S().Warn(err)
-			continue
-		}
-
-		// Update Postgres
-		transactionCount = &models.TransactionCount{
-			Type:  "token_transfer",
-			Count: uint64(count),
-		}
-		err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
-
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+func transactionCountRoutine() error {
+
+	/////////////
+	// Regular //
+	/////////////
+
+	// Count
+	count, err := crud.GetTransactionModel().CountRegular()
+	if err != nil {
+		// Postgres error
+		return err
+	}
+
+	// Update Redis
+	countKey := config.Config.RedisKeyPrefix + "transaction_count_regular"
+	err = redis.GetRedisClient().SetCount(countKey, count)
+	if err != nil {
+		// Redis error
+		return err
 	}
+
+	// Update Postgres
+	transactionCount := &models.TransactionCount{
+		Type:  "regular",
+		Count: uint64(count),
+	}
+	err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
+
+	//////////////
+	// Internal //
+	//////////////
+
+	// Count
+	count, err = crud.GetTransactionModel().CountInternal()
+	if err != nil {
+		// Postgres error
+		return err
+	}
+
+	// Update Redis
+	countKey = config.Config.RedisKeyPrefix + "transaction_count_internal"
+	err = redis.GetRedisClient().SetCount(countKey, count)
+	if err != nil {
+		// Redis error
+		return err
+	}
+
+	// Update Postgres
+	transactionCount = &models.TransactionCount{
+		Type:  "internal",
+		Count: uint64(count),
+	}
+	err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
+
+	////////////////////
+	// Token Transfer //
+	////////////////////
+
+	// Count
+	count, err = crud.GetTokenTransferModel().Count()
+	if err != nil {
+		// Postgres error
+		return err
+	}
+
+	// Update Redis
+	countKey = config.Config.RedisKeyPrefix + "transaction_count_token_transfer"
+	err = redis.GetRedisClient().SetCount(countKey, count)
+	if err != nil {
+		// Redis error
+		return err
+	}
+
+	// Update Postgres
+	transactionCount = &models.TransactionCount{
+		Type:  "token_transfer",
+		Count: uint64(count),
+	}
+	err = crud.GetTransactionCountModel().UpsertOne(transactionCount)
+
+	return nil
 }
@@ -2,74 +2,112 @@ package routines
 
 import (
 	"errors"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/metrics"
 	"github.com/geometry-labs/icon-transactions/models"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 	"github.com/geometry-labs/icon-transactions/worker/utils"
 )
 
+const transactionMissingRoutineName = "transaction_missing"
+
+// Blocks checked between checkpoints
+const transactionMissingCheckpointBlocks = 1000
+
 func StartTransactionMissingRoutine() {
 
-	// routine every day
-	go transactionMissingRoutine(3600 * time.Second)
+	// routine on schedule, ROUTINE_TRANSACTION_MISSING_SCHEDULE
+	scheduler.Start(transactionMissingRoutineName, config.Config.RoutineTransactionMissingSchedule, transactionMissingRoutine)
 }
 
-func transactionMissingRoutine(duration time.Duration) {
+// NOTE the cursor is the last block checked, it is kept when a run completes
+// Each run checks the blocks from the cursor to the chain tip
+func transactionMissingRoutine() error {
 
-	// Loop every duration
-	for {
+	// Resume from the last block checked
+	cursor, err := loadCheckpoint(transactionMissingRoutineName)
+	if err != nil {
+		return err
+	}
+	currentBlockNumber := 1
+	if cursor != "" {
+		lastBlockNumber, err := strconv.Atoi(cursor)
+		if err != nil {
+			return errors.New("Invalid checkpoint " + cursor)
+		}
+		currentBlockNumber = lastBlockNumber + 1
+	}
 
-		currentBlockNumber := 1
+	// Chain tip
+	tipBlockNumber, err := utils.IconNodeServiceGetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+	metrics.RoutineTipBlockNumberGaugeVec.WithLabelValues(transactionMissingRoutineName).Set(float64(tipBlockNumber))
+
+	checked := 0
+	for currentBlockNumber <= tipBlockNumber {
+
+		transactionHashes, err := utils.IconNodeServiceGetBlockTransactionHashes(currentBlockNumber)
+		if err != nil {
+			zap.S().Warn(
+				"Routine=TransactionMissing",
+				" CurrentBlockNumber=", currentBlockNumber,
+				" Error=", err.Error(),
+				" Sleeping 1 second...",
+			)
+
+			time.Sleep(1 * time.Second)
+			continue
+		}
 
-		for {
+		isChecked := true
+		for _, txHash := range *transactionHashes {
+			tx, err := crud.GetTransactionModel().SelectOne(txHash, -1)
 
-			transactionHashes, err := utils.IconNodeServiceGetBlockTransactionHashes(currentBlockNumber)
-			if err != nil {
-				zap.S().Warn(
-					"Routine=TransactionMissing",
-					" CurrentBlockNumber=", currentBlockNumber,
-					" Error=", err.Error(),
-					" Sleeping 1 second...",
-				)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				zap.S().Warn("Loader=TransactionMissing Number=", currentBlockNumber, " Error=", err.Error(), " - Retrying...")
 
 				time.Sleep(1 * time.Second)
-				continue
-			}
-
-			for _, txHash := range *transactionHashes {
-				tx, err := crud.GetTransactionModel().SelectOne(txHash, -1)
-
-				if errors.Is(err, gorm.ErrRecordNotFound) || tx.Signature == "" {
-					transactionMissing := &models.TransactionMissing{
-						Hash: txHash,
-					}
-
-					crud.GetTransactionMissingModel().LoaderChannel <- transactionMissing
-				} else if err != nil {
-					zap.S().Warn("Loader=TransactionMissing Number=", currentBlockNumber, " Error=", err.Error(), " - Retrying...")
-
-					time.Sleep(1 * time.Second)
-					continue
+				isChecked = false
+				break
+			} else if err != nil || tx.Signature == "" {
+				transactionMissing := &models.TransactionMissing{
+					Hash: txHash,
 				}
 
+				crud.GetTransactionMissingModel().LoaderChannel <- transactionMissing
 			}
+		}
+		if isChecked == false {
+			// Retry the block, the checkpoint must not pass it
+			continue
+		}
+		checked++
 
-			if currentBlockNumber > 44000000 {
-				break
-			}
-
-			if currentBlockNumber%100000 == 0 {
-				zap.S().Info("Routine=TransactionMissing, CurrentBlockNumber= ", currentBlockNumber, " - Checked 100,000 blocks...")
+		// Checkpoint
+		if checked == transactionMissingCheckpointBlocks || currentBlockNumber == tipBlockNumber {
+			err = saveCheckpoint(transactionMissingRoutineName, strconv.Itoa(currentBlockNumber), checked)
+			if err != nil {
+				return err
 			}
+			metrics.RoutineBlockNumberGaugeVec.WithLabelValues(transactionMissingRoutineName).Set(float64(currentBlockNumber))
+			checked = 0
+		}
 
-			currentBlockNumber++
+		if currentBlockNumber%100000 == 0 {
+			zap.S().Info("Routine=TransactionMissing, CurrentBlockNumber= ", currentBlockNumber, " - Checked 100,000 blocks...")
 		}
 
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+		currentBlockNumber++
 	}
+
+	return nil
 }
@@ -2,82 +2,91 @@ package routines
 
 import (
 	"errors"
-	"time"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 )
 
+const tokenTransferCountByAddressRoutineName = "token_transfer_count_by_address"
+
 func StartTokenTransferCountByAddressRoutine() {
 
-	// routine every day
-	go tokenTransferCountByAddressRoutine(3600 * time.Second)
+	// routine on schedule, ROUTINE_TOKEN_TRANSFER_COUNT_BY_ADDRESS_SCHEDULE
+	scheduler.Start(tokenTransferCountByAddressRoutineName, config.Config.RoutineTokenTransferCountByAddressSchedule, tokenTransferCountByAddressRoutine)
 }
 
-func tokenTransferCountByAddressRoutine(duration time.Duration) {
-
-	// Loop every duration
-	for {
+func tokenTransferCountByAddressRoutine() error {
 
-		// Loop through all addresses
-		skip := 0
-		limit := 1000
-		for {
-			addresses, err := crud.GetTokenTransferCountByAddressModel().SelectMany(limit, skip)
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				// Sleep
-				zap.S().Info("Routine=TokenTransferCountByAddress", " - No records found, sleeping...")
-				break
-			} else if err != nil {
-				zap.S().Fatal(err.Error())
-			}
-			if len(*addresses) == 0 {
-				// Sleep
-				break
-			}
+	// Resume from the last address processed
+	address, err := loadCheckpoint(tokenTransferCountByAddressRoutineName)
+	if err != nil {
+		return err
+	}
 
-			zap.S().Info("Routine=TokenTransferCountByAddress", " - Processing ", len(*addresses), " addresses...")
-			for _, a := range *addresses {
+	// Loop through all addresses
+	limit := 1000
+	for {
+		addresses, err := crud.GetTokenTransferCountByAddressModel().SelectManyAfterAddress(limit, address)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			zap.S().Info("Routine=TokenTransferCountByAddress", " - No records found, sleeping...")
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*addresses) == 0 {
+			// Done
+			break
+		}
 
-				///////////
-				// Count //
-				///////////
-				count, err := crud.GetTokenTransferCountByAddressIndexModel().CountByAddress(a.Address)
-				if err != nil {
-					// Postgres error
-					zap.S().Warn(err)
-					continue
-				}
+		zap.S().Info("Routine=TokenTransferCountByAddress", " - Processing ", len(*addresses), " addresses...")
+		for _, a := range *addresses {
 
-				//////////////////
-				// Update Redis //
-				//////////////////
-				countKey := "icon_transactions_token_transfer_count_by_address_" + a.Address
-				err = redis.GetRedisClient().SetCount(countKey, count)
-				if err != nil {
-					// Redis error
-					zap.S().Warn(err)
-					continue
-				}
+			///////////
+			// Count //
+			///////////
+			count, err := crud.GetTokenTransferCountByAddressIndexModel().CountByAddress(a.Address)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn(err)
+				continue
+			}
 
-				/////////////////////
-				// Update Postgres //
-				/////////////////////
-				tokenTransferCountByAddress := &models.TokenTransferCountByAddress{
-					Address: a.Address,
-					Count:   uint64(count),
-				}
-				err = crud.GetTokenTransferCountByAddressModel().UpsertOne(tokenTransferCountByAddress)
+			//////////////////
+			// Update Redis //
+			//////////////////
+			countKey := config.Config.RedisKeyPrefix + "token_transfer_count_by_address_" + a.Address
+			err = redis.GetRedisClient().SetCount(countKey, count)
+			if err != nil {
+				// Redis error
+				zap.S().Warn(err)
+				continue
 			}
 
-			skip += limit
+			/////////////////////
+			// Update Postgres //
+			/////////////////////
+			tokenTransferCountByAddress := &models.TokenTransferCountByAddress{
+				Address: a.Address,
+				Count:   uint64(count),
+			}
+			err = crud.GetTokenTransferCountByAddressModel().UpsertOne(tokenTransferCountByAddress)
 		}
 
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+		// Checkpoint
+		address = (*addresses)[len(*addresses)-1].Address
+		err = saveCheckpoint(tokenTransferCountByAddressRoutineName, address, len(*addresses))
+		if err != nil {
+			return err
+		}
 	}
+
+	// Completed, the next run starts over
+	return saveCheckpoint(tokenTransferCountByAddressRoutineName, "", 0)
 }
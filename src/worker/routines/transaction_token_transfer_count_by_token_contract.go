@@ -2,82 +2,91 @@ package routines
 
 import (
 	"errors"
-	"time"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/geometry-labs/icon-transactions/config"
 	"github.com/geometry-labs/icon-transactions/crud"
 	"github.com/geometry-labs/icon-transactions/models"
 	"github.com/geometry-labs/icon-transactions/redis"
+	"github.com/geometry-labs/icon-transactions/worker/scheduler"
 )
 
+const tokenTransferCountByTokenContractRoutineName = "token_transfer_count_by_token_contract"
+
 func StartTokenTransferCountByTokenContractRoutine() {
 
-	// routine every day
-	go tokenTransferCountByTokenContractRoutine(3600 * time.Second)
+	// routine on schedule, ROUTINE_TOKEN_TRANSFER_COUNT_BY_TOKEN_CONTRACT_SCHEDULE
+	scheduler.Start(tokenTransferCountByTokenContractRoutineName, config.Config.RoutineTokenTransferCountByTokenContractSchedule, tokenTransferCountByTokenContractRoutine)
 }
 
-func tokenTransferCountByTokenContractRoutine(duration time.Duration) {
-
-	// Loop every duration
-	for {
+func tokenTransferCountByTokenContractRoutine() error {
 
-		// Loop through all addresses
-		skip := 0
-		limit := 1000
-		for {
-			tokenTransfers, err := crud.GetTokenTransferModel().SelectManyDistinctTokenContracts(limit, skip)
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				// Sleep
-				zap.S().Info("Routine=TokenTransferCountByTokenContract", " - No records found, sleeping...")
-				break
-			} else if err != nil {
-				zap.S().Fatal(err.Error())
-			}
-			if len(*tokenTransfers) == 0 {
-				// Sleep
-				break
-			}
+	// Resume from the last token contract processed
+	tokenContractAddress, err := loadCheckpoint(tokenTransferCountByTokenContractRoutineName)
+	if err != nil {
+		return err
+	}
 
-			zap.S().Info("Routine=TokenTransferCountByTokenContract", " - Processing ", len(*tokenTransfers), " addresses...")
-			for _, t := range *tokenTransfers {
+	// Loop through all addresses
+	limit := 1000
+	for {
+		tokenTransfers, err := crud.GetTokenTransferModel().SelectManyDistinctTokenContractsAfter(limit, tokenContractAddress)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Done
+			zap.S().Info("Routine=TokenTransferCountByTokenContract", " - No records found, sleeping...")
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(*tokenTransfers) == 0 {
+			// Done
+			break
+		}
 
-				///////////
-				// Count //
-				///////////
-				count, err := crud.GetTokenTransferModel().CountByTokenContract(t.TokenContractAddress)
-				if err != nil {
-					// Postgres error
-					zap.S().Warn(err)
-					continue
-				}
+		zap.S().Info("Routine=TokenTransferCountByTokenContract", " - Processing ", len(*tokenTransfers), " addresses...")
+		for _, t := range *tokenTransfers {
 
-				//////////////////
-				// Update Redis //
-				//////////////////
-				countKey := "icon_transactions_token_transfer_count_by_token_contract_" + t.TokenContractAddress
-				err = redis.GetRedisClient().SetCount(countKey, count)
-				if err != nil {
-					// Redis error
-					zap.S().Warn(err)
-					continue
-				}
+			///////////
+			// Count //
+			///////////
+			count, err := crud.GetTokenTransferModel().CountByTokenContract(t.TokenContractAddress)
+			if err != nil {
+				// Postgres error
+				zap.S().Warn(err)
+				continue
+			}
 
-				/////////////////////
-				// Update Postgres //
-				/////////////////////
-				tokenTransferCountByTokenContract := &models.TokenTransferCountByTokenContract{
-					TokenContract: t.TokenContractAddress,
-					Count:         uint64(count),
-				}
-				err = crud.GetTokenTransferCountByTokenContractModel().UpsertOne(tokenTransferCountByTokenContract)
+			//////////////////
+			// Update Redis //
+			//////////////////
+			countKey := config.Config.RedisKeyPrefix + "token_transfer_count_by_token_contract_" + t.TokenContractAddress
+			err = redis.GetRedisClient().SetCount(countKey, count)
+			if err != nil {
+				// Redis error
+				zap.S().Warn(err)
+				continue
 			}
 
-			skip += limit
+			/////////////////////
+			// Update Postgres //
+			/////////////////////
+			tokenTransferCountByTokenContract := &models.TokenTransferCountByTokenContract{
+				TokenContract: t.TokenContractAddress,
+				Count:         uint64(count),
+			}
+			err = crud.GetTokenTransferCountByTokenContractModel().UpsertOne(tokenTransferCountByTokenContract)
 		}
 
-		zap.S().Info("Completed routine, sleeping...")
-		time.Sleep(duration)
+		// Checkpoint
+		tokenContractAddress = (*tokenTransfers)[len(*tokenTransfers)-1].TokenContractAddress
+		err = saveCheckpoint(tokenTransferCountByTokenContractRoutineName, tokenContractAddress, len(*tokenTransfers))
+		if err != nil {
+			return err
+		}
 	}
+
+	// Completed, the next run starts over
+	return saveCheckpoint(tokenTransferCountByTokenContractRoutineName, "", 0)
 }
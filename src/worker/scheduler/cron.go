@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule - parsed cron expression
+// Fields: minute hour day-of-month month day-of-week, ex "0 * * * *"
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// NOTE days match on either field when both are restricted, like cron
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+var scheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type scheduleField struct {
+	name string
+	min  int
+	max  int
+}
+
+var scheduleFields = []scheduleField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7}, // 0 and 7 are sunday
+}
+
+// ParseSchedule - parse a cron expression or a descriptor, ex "@daily"
+func ParseSchedule(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if descriptor, ok := scheduleDescriptors[expression]; ok {
+		expression = descriptor
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(scheduleFields) {
+		return nil, errors.New("Invalid schedule " + expression + ", expected 5 fields")
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		var err error
+		bits[i], err = parseScheduleField(field, scheduleFields[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Sunday
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &Schedule{
+		minute:         bits[0],
+		hour:           bits[1],
+		dayOfMonth:     bits[2],
+		month:          bits[3],
+		dayOfWeek:      bits[4],
+		dayOfMonthStar: strings.HasPrefix(fields[2], "*"),
+		dayOfWeekStar:  strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseScheduleField - bit set of a field, ex "*/15", "1-5" or "0,30"
+func parseScheduleField(field string, f scheduleField) (uint64, error) {
+	bits := uint64(0)
+
+	for _, part := range strings.Split(field, ",") {
+		invalid := errors.New("Invalid " + f.name + " " + part)
+
+		// Step
+		step := 1
+		rangeStep := strings.SplitN(part, "/", 2)
+		if len(rangeStep) == 2 {
+			var err error
+			step, err = strconv.Atoi(rangeStep[1])
+			if err != nil || step < 1 {
+				return 0, invalid
+			}
+		}
+
+		// Range
+		start, end := f.min, f.max
+		if rangeStep[0] != "*" {
+			startEnd := strings.SplitN(rangeStep[0], "-", 2)
+
+			var err error
+			start, err = strconv.Atoi(startEnd[0])
+			if err != nil {
+				return 0, invalid
+			}
+
+			end = start
+			if len(startEnd) == 2 {
+				end, err = strconv.Atoi(startEnd[1])
+				if err != nil {
+					return 0, invalid
+				}
+			} else if len(rangeStep) == 2 {
+				// ex 5/15, from 5 to the max
+				end = f.max
+			}
+		}
+		if start < f.min || end > f.max || start > end {
+			return 0, invalid
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+// Next - first time matching the schedule after t, to the minute
+// Returns: zero time if no time matches within 5 years, ex "0 0 30 2 *"
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.matchDay(t) == false {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
@@ -0,0 +1,74 @@
+//+build unit
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleNext(t *testing.T) {
+	assert := assert.New(t)
+
+	// Saturday
+	now := time.Date(2021, 7, 10, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		next       time.Time
+	}{
+		{"* * * * *", time.Date(2021, 7, 10, 10, 31, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2021, 7, 10, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2021, 7, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2021, 7, 11, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2021, 7, 10, 10, 40, 0, 0, time.UTC)},
+		{"15,45 9-17 * * *", time.Date(2021, 7, 10, 10, 45, 0, 0, time.UTC)},
+		{"0 0 * * 1-5", time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2021, 7, 11, 0, 0, 0, 0, time.UTC)},
+		{"30 2 29 2 *", time.Date(2024, 2, 29, 2, 30, 0, 0, time.UTC)},
+
+		// Either day field matches when both are set
+		{"0 0 15 * 1", time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC)},
+
+		// Both day fields match when one starts with *, ex odd days on mondays
+		{"0 0 */2 * 1", time.Date(2021, 7, 19, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.expression)
+		assert.Equal(nil, err, test.expression)
+		assert.Equal(test.next, schedule.Next(now), test.expression)
+	}
+
+	// No matching day
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	assert.Equal(nil, err)
+	assert.Equal(true, schedule.Next(now).IsZero())
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	expressions := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+		"@every 1h",
+	}
+
+	for _, expression := range expressions {
+		_, err := ParseSchedule(expression)
+		assert.NotEqual(nil, err, expression)
+	}
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/geometry-labs/icon-transactions/crud"
+	"github.com/geometry-labs/icon-transactions/models"
+)
+
+const (
+	statusRunning   = "running"
+	statusSucceeded = "succeeded"
+	statusFailed    = "failed"
+)
+
+// Start - run a routine on a cron schedule, ex "@hourly"
+// NOTE each run is taken by one worker across replicas, runs missed while no worker was up are run on start
+func Start(name string, expression string, run func() error) {
+	schedule, err := ParseSchedule(expression)
+	if err != nil {
+		zap.S().Fatal("Routine=", name, " - Error: ", err.Error())
+	}
+
+	go scheduleRoutine(name, expression, schedule, run)
+}
+
+func scheduleRoutine(name string, expression string, schedule *Schedule, run func() error) {
+	model := crud.GetRoutineScheduleModel()
+
+	// Missed runs
+	// NOTE slot is the scheduled time of a run, used to skip runs already taken by another worker
+	isDue := false
+	slot := uint64(0)
+	routineSchedule, err := model.SelectOne(name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		isDue = true
+	} else if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+	} else if routineSchedule.LastRunStartTimestamp == 0 {
+		isDue = true
+	} else if routineSchedule.NextRunTimestamp <= timestamp(time.Now()) {
+		isDue = true
+		slot = routineSchedule.NextRunTimestamp
+	}
+
+	for {
+		runAt := time.Now()
+		if isDue == false {
+			runAt = schedule.Next(time.Now().UTC())
+			if runAt.IsZero() {
+				zap.S().Warn("Routine=", name, " - Schedule ", expression, " has no next run, stopping")
+				return
+			}
+			slot = timestamp(runAt)
+		}
+		isDue = false
+
+		err := model.UpsertSchedule(name, expression, timestamp(runAt))
+		if err != nil {
+			zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+		}
+
+		time.Sleep(time.Until(runAt))
+
+		runRoutine(name, slot, run)
+	}
+}
+
+// runRoutine - run a routine if no other worker is running or has run it for the slot
+func runRoutine(name string, slot uint64, run func() error) {
+	model := crud.GetRoutineScheduleModel()
+
+	unlock, isLocked, err := model.TryLock(name)
+	if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+		return
+	}
+	if isLocked == false {
+		zap.S().Info("Routine=", name, " - Running on another worker, skipping...")
+		return
+	}
+	defer unlock()
+
+	routineSchedule, err := model.SelectOne(name)
+	if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+		return
+	}
+	if routineSchedule.LastRunStartTimestamp != 0 && routineSchedule.LastRunStartTimestamp >= slot {
+		zap.S().Info("Routine=", name, " - Run by another worker, skipping...")
+		return
+	}
+
+	worker, _ := os.Hostname()
+	routineSchedule = &models.RoutineSchedule{
+		Name:                  name,
+		Status:                statusRunning,
+		Worker:                worker,
+		LastRunStartTimestamp: timestamp(time.Now()),
+	}
+	err = model.UpdateRun(routineSchedule)
+	if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+		return
+	}
+
+	zap.S().Info("Routine=", name, " - Starting routine...")
+	err = run()
+
+	routineSchedule.Status = statusSucceeded
+	routineSchedule.LastRunEndTimestamp = timestamp(time.Now())
+	if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+		routineSchedule.Status = statusFailed
+		routineSchedule.Error = err.Error()
+	}
+
+	err = model.UpdateRun(routineSchedule)
+	if err != nil {
+		zap.S().Warn("Routine=", name, " - Error: ", err.Error())
+	}
+
+	zap.S().Info("Routine=", name, " - Completed routine, sleeping...")
+}
+
+// timestamp - microseconds since epoch, like the other timestamps of the tables
+func timestamp(t time.Time) uint64 {
+	return uint64(t.UnixNano() / 1000)
+}
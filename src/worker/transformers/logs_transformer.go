@@ -3,6 +3,7 @@ package transformers
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -34,6 +35,7 @@ func logsTransformer() {
 	transactionInternalCountByAddressLoaderChan := crud.GetTransactionInternalCountByAddressModel().LoaderChannel
 	tokenTransferCountByAddressLoaderChan := crud.GetTokenTransferCountByAddressModel().LoaderChannel
 	tokenTransferCountByTokenContractLoaderChan := crud.GetTokenTransferCountByTokenContractModel().LoaderChannel
+	multiTokenTransferLoaderChan := crud.GetMultiTokenTransferModel().LoaderChannel
 
 	zap.S().Debug("Logs Transformer: started working")
 	for {
@@ -100,6 +102,12 @@ func logsTransformer() {
 			tokenTransferCountByTokenContractLoaderChan <- tokenTransferCountByTokenContract
 		}
 
+		// Loads to: multi_token_transfers
+		multiTokenTransfers := transformLogRawToMultiTokenTransfers(logRaw)
+		for _, multiTokenTransfer := range multiTokenTransfers {
+			multiTokenTransferLoaderChan <- multiTokenTransfer
+		}
+
 		/////////////
 		// Metrics //
 		/////////////
@@ -263,6 +271,85 @@ func transformLogRawToTokenTransfer(logRaw *models.LogRaw) *models.TokenTransfer
 	}
 }
 
+// IRC-31 TransferSingle and TransferBatch events
+// NOTE TransferBatch events are expanded to one transfer per token id
+func transformLogRawToMultiTokenTransfers(logRaw *models.LogRaw) []*models.MultiTokenTransfer {
+
+	var indexed []string
+	err := json.Unmarshal([]byte(logRaw.Indexed), &indexed)
+	if err != nil {
+		zap.S().Fatal("Unable to parse indexed field in log; indexed=", logRaw.Indexed, " error: ", err.Error())
+	}
+
+	if len(indexed) != 4 {
+		// Not multi token transfer
+		return nil
+	}
+
+	isTransferSingle := indexed[0] == "TransferSingle(Address,Address,Address,int,int)"
+	isTransferBatch := indexed[0] == "TransferBatch(Address,Address,Address,bytes,bytes)"
+	if isTransferSingle == false && isTransferBatch == false {
+		// Not multi token transfer
+		return nil
+	}
+
+	var data []string
+	err = json.Unmarshal([]byte(logRaw.Data), &data)
+	if err != nil || len(data) != 2 {
+		zap.S().Warn("Unable to parse data field in multi token transfer log; hash=", logRaw.TransactionHash, " data=", logRaw.Data)
+		return nil
+	}
+
+	// Token IDs and Values
+	tokenIDs := []string{}
+	values := []string{}
+	if isTransferSingle == true {
+		tokenIDs = append(tokenIDs, data[0])
+		values = append(values, data[1])
+	} else {
+		tokenIDsBig, err := utils.RLPHexToBigIntList(data[0])
+		if err != nil {
+			zap.S().Warn("Unable to decode _ids in TransferBatch log; hash=", logRaw.TransactionHash, " error: ", err.Error())
+			return nil
+		}
+
+		valuesBig, err := utils.RLPHexToBigIntList(data[1])
+		if err != nil {
+			zap.S().Warn("Unable to decode _values in TransferBatch log; hash=", logRaw.TransactionHash, " error: ", err.Error())
+			return nil
+		}
+
+		if len(tokenIDsBig) != len(valuesBig) {
+			zap.S().Warn("Mismatched _ids and _values in TransferBatch log; hash=", logRaw.TransactionHash)
+			return nil
+		}
+
+		for i := range tokenIDsBig {
+			tokenIDs = append(tokenIDs, fmt.Sprintf("0x%x", tokenIDsBig[i]))
+			values = append(values, fmt.Sprintf("0x%x", valuesBig[i]))
+		}
+	}
+
+	multiTokenTransfers := []*models.MultiTokenTransfer{}
+	for i := range tokenIDs {
+		multiTokenTransfers = append(multiTokenTransfers, &models.MultiTokenTransfer{
+			TokenContractAddress: logRaw.Address,
+			TokenId:              tokenIDs[i],
+			OperatorAddress:      indexed[1],
+			FromAddress:          indexed[2],
+			ToAddress:            indexed[3],
+			Value:                values[i],
+			TransactionHash:      logRaw.TransactionHash,
+			LogIndex:             int32(logRaw.LogIndex),
+			BatchIndex:           int32(i),
+			BlockNumber:          logRaw.BlockNumber,
+			BlockTimestamp:       logRaw.BlockTimestamp,
+		})
+	}
+
+	return multiTokenTransfers
+}
+
 func transformTransactionToTransactionCountInternal(transaction *models.Transaction) *models.TransactionCount {
 
 	return &models.TransactionCount{
@@ -30,6 +30,7 @@ func transactionsTransformer() {
 	// Output channels
 	transactionLoaderChan := crud.GetTransactionModel().LoaderChannel
 	transactionCreateScoreLoaderChan := crud.GetTransactionCreateScoreModel().LoaderChannel
+	contractLoaderChan := crud.GetContractModel().LoaderChannel
 	transactionWebsocketLoaderChan := crud.GetTransactionWebsocketIndexModel().LoaderChannel
 	//transactionCountLoaderChan := crud.GetTransactionCountModel().LoaderChannel
 	transactionCountByAddressLoaderChan := crud.GetTransactionCountByAddressModel().LoaderChannel
@@ -62,6 +63,12 @@ func transactionsTransformer() {
 			transactionCreateScoreLoaderChan <- transactionCreateScore
 		}
 
+		// Loads to: contracts, contract_updates
+		contractUpdate := transformTransactionToContractUpdate(transaction)
+		if contractUpdate != nil {
+			contractLoaderChan <- contractUpdate
+		}
+
 		// Loads to: transaction_websocket_indices
 		transactionWebsocket := transformTransactionToTransactionWS(transaction)
 		transactionWebsocketLoaderChan <- transactionWebsocket
@@ -229,6 +236,24 @@ func transformTransactionToTransactionCreateScore(tx *models.Transaction) *model
 	}
 }
 
+func transformTransactionToContractUpdate(tx *models.Transaction) *models.ContractUpdate {
+
+	if tx.DataType != "deploy" || tx.ReceiptStatus != 1 {
+		// Not successful deploy transaction
+		return nil
+	}
+
+	// NOTE to address set to the new contract address for creations
+	return &models.ContractUpdate{
+		TransactionHash: tx.Hash,
+		ContractAddress: tx.ToAddress,
+		DeployerAddress: tx.FromAddress,
+		IsCreation:      tx.Method == "_create_contract_",
+		BlockNumber:     tx.BlockNumber,
+		BlockTimestamp:  tx.BlockTimestamp,
+	}
+}
+
 // Business logic goes here
 func transformTransactionToTransactionWS(tx *models.Transaction) *models.TransactionWebsocket {
 
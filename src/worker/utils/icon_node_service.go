@@ -109,7 +109,7 @@ func IconNodeServiceGetBlockTransactionHashes(height int) (*[]string, error) {
 func IconNodeServiceGetTokenDecimalBase(tokenContractAddress string) (int, error) {
 
 	// Redis cache
-	redisCacheKey := "icon_transactions_token_contract_decimals_" + tokenContractAddress
+	redisCacheKey := config.Config.RedisKeyPrefix + "token_contract_decimals_" + tokenContractAddress
 	decimals, err := redis.GetRedisClient().GetCount(redisCacheKey)
 	if err != nil {
 		zap.S().Fatal(err)
@@ -191,7 +191,7 @@ func IconNodeServiceGetTokenDecimalBase(tokenContractAddress string) (int, error
 func IconNodeServiceGetTokenContractName(tokenContractAddress string) (string, error) {
 
 	// Redis cache
-	redisCacheKey := "icon_transactions_token_contract_name_" + tokenContractAddress
+	redisCacheKey := config.Config.RedisKeyPrefix + "token_contract_name_" + tokenContractAddress
 	tokenContractName, err := redis.GetRedisClient().GetValue(redisCacheKey)
 	if err != nil {
 		zap.S().Fatal(err)
@@ -272,7 +272,7 @@ func IconNodeServiceGetTokenContractName(tokenContractAddress string) (string, e
 func IconNodeServiceGetTokenContractSymbol(tokenContractAddress string) (string, error) {
 
 	// Redis cache
-	redisCacheKey := "icon_transactions_token_contract_symbol_" + tokenContractAddress
+	redisCacheKey := config.Config.RedisKeyPrefix + "token_contract_symbol_" + tokenContractAddress
 	tokenContractSymbol, err := redis.GetRedisClient().GetValue(redisCacheKey)
 	if err != nil {
 		zap.S().Fatal(err)
@@ -350,7 +350,8 @@ func IconNodeServiceGetTokenContractSymbol(tokenContractAddress string) (string,
 	return tokenContractSymbol, nil
 }
 
-func IconNodeServiceGetTokenBalance(tokenContractAddress string, tokenHolderAddress string) (string, error) {
+// IconNodeServiceGetTokenBalance - balance of a token holder at a block height
+func IconNodeServiceGetTokenBalance(tokenContractAddress string, tokenHolderAddress string, height uint64) (string, error) {
 
 	// Request icon contract
 	url := config.Config.IconNodeServiceURL
@@ -365,9 +366,10 @@ func IconNodeServiceGetTokenBalance(tokenContractAddress string, tokenHolderAddr
         "data": {
             "method": "balanceOf",
 						"params": {"_owner": "%s"}
-        }
+        },
+        "height": "0x%x"
     }
-	}`, tokenContractAddress, tokenHolderAddress)
+	}`, tokenContractAddress, tokenHolderAddress, height)
 
 	// Create http client
 	client := &http.Client{}
@@ -414,3 +416,248 @@ func IconNodeServiceGetTokenBalance(tokenContractAddress string, tokenHolderAddr
 
 	return tokenBalance, nil
 }
+
+func IconNodeServiceGetTokenTotalSupply(tokenContractAddress string) (string, error) {
+
+	// Request icon contract
+	url := config.Config.IconNodeServiceURL
+	method := "POST"
+	payload := fmt.Sprintf(`{
+    "jsonrpc": "2.0",
+    "id": 1234,
+    "method": "icx_call",
+    "params": {
+        "to": "%s",
+        "dataType": "call",
+        "data": {
+            "method": "totalSupply"
+        }
+    }
+	}`, tokenContractAddress)
+
+	// Create http client
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, strings.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+
+	// Execute request
+	req.Header.Add("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	// Read body
+	bodyString, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// Check status code
+	if res.StatusCode != 200 {
+		return "", errors.New(
+			"StatusCode=" + strconv.Itoa(res.StatusCode) +
+				",Request=" + payload +
+				",Response=" + string(bodyString),
+		)
+	}
+
+	// Parse body
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bodyString, &body)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract total supply
+	totalSupply, ok := body["result"].(string)
+	if ok == false {
+		return "", errors.New("Invalid response")
+	}
+
+	return totalSupply, nil
+}
+
+func IconNodeServiceGetBalance(address string) (string, error) {
+
+	// Request icon contract
+	url := config.Config.IconNodeServiceURL
+	method := "POST"
+	payload := fmt.Sprintf(`{
+    "jsonrpc": "2.0",
+    "id": 1234,
+    "method": "icx_getBalance",
+    "params": {
+        "address": "%s"
+    }
+	}`, address)
+
+	// Create http client
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, strings.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+
+	// Execute request
+	req.Header.Add("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	// Read body
+	bodyString, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// Check status code
+	if res.StatusCode != 200 {
+		return "", errors.New(
+			"StatusCode=" + strconv.Itoa(res.StatusCode) +
+				",Request=" + payload +
+				",Response=" + string(bodyString),
+		)
+	}
+
+	// Parse body
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bodyString, &body)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract balance
+	balance, ok := body["result"].(string)
+	if ok == false {
+		return "", errors.New("Invalid response")
+	}
+
+	return balance, nil
+}
+
+func IconNodeServiceGetTotalSupply() (string, error) {
+
+	// Request icon contract
+	url := config.Config.IconNodeServiceURL
+	method := "POST"
+	payload := `{
+    "jsonrpc": "2.0",
+    "id": 1234,
+    "method": "icx_getTotalSupply"
+	}`
+
+	// Create http client
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, strings.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+
+	// Execute request
+	req.Header.Add("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	// Read body
+	bodyString, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	// Check status code
+	if res.StatusCode != 200 {
+		return "", errors.New(
+			"StatusCode=" + strconv.Itoa(res.StatusCode) +
+				",Request=" + payload +
+				",Response=" + string(bodyString),
+		)
+	}
+
+	// Parse body
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bodyString, &body)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract total supply
+	totalSupply, ok := body["result"].(string)
+	if ok == false {
+		return "", errors.New("Invalid response")
+	}
+
+	return totalSupply, nil
+}
+
+func IconNodeServiceGetLastBlockHeight() (int, error) {
+
+	// Request icon contract
+	url := config.Config.IconNodeServiceURL
+	method := "POST"
+	payload := `{
+    "jsonrpc": "2.0",
+    "id": 1234,
+    "method": "icx_getLastBlock"
+	}`
+
+	// Create http client
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, strings.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	// Execute request
+	req.Header.Add("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// Read body
+	bodyString, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	// Check status code
+	if res.StatusCode != 200 {
+		return 0, errors.New(
+			"StatusCode=" + strconv.Itoa(res.StatusCode) +
+				",Request=" + payload +
+				",Response=" + string(bodyString),
+		)
+	}
+
+	// Parse body
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bodyString, &body)
+	if err != nil {
+		return 0, err
+	}
+
+	// Extract result
+	result, ok := body["result"].(map[string]interface{})
+	if ok == false {
+		return 0, errors.New("Cannot read result")
+	}
+
+	// Extract height
+	// NOTE blocks of icx_getLastBlock are in the v2 format, heights are numbers
+	height, ok := result["height"].(float64)
+	if ok == false {
+		return 0, errors.New("Cannot read height")
+	}
+
+	return int(height), nil
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// RLPHexToBigIntList - decode a 0x prefixed hex RLP list of integers
+// Used to parse the _ids and _values fields of IRC-31 TransferBatch events
+func RLPHexToBigIntList(rlpHex string) ([]*big.Int, error) {
+
+	rlpBytes, err := hex.DecodeString(strings.TrimPrefix(rlpHex, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Outer list
+	payload, rest, isList, err := rlpSplit(rlpBytes)
+	if err != nil {
+		return nil, err
+	}
+	if isList == false || len(rest) != 0 {
+		return nil, errors.New("RLP value is not a single list")
+	}
+
+	// List items
+	values := []*big.Int{}
+	for len(payload) > 0 {
+		var item []byte
+		item, payload, isList, err = rlpSplit(payload)
+		if err != nil {
+			return nil, err
+		}
+		if isList == true {
+			return nil, errors.New("RLP list contains a nested list")
+		}
+
+		values = append(values, new(big.Int).SetBytes(item))
+	}
+
+	return values, nil
+}
+
+// rlpSplit - split the first RLP item from b
+// Returns: item payload, remaining bytes, is list, error (if present)
+func rlpSplit(b []byte) ([]byte, []byte, bool, error) {
+	if len(b) == 0 {
+		return nil, nil, false, errors.New("RLP value is empty")
+	}
+
+	prefix := b[0]
+	switch {
+	case prefix < 0x80:
+		// Single byte
+		return b[:1], b[1:], false, nil
+	case prefix < 0xb8:
+		// Short string
+		return rlpSplitPayload(b, 1, uint64(prefix-0x80), false)
+	case prefix < 0xc0:
+		// Long string
+		size, err := rlpReadSize(b, int(prefix-0xb7))
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return rlpSplitPayload(b, 1+int(prefix-0xb7), size, false)
+	case prefix < 0xf8:
+		// Short list
+		return rlpSplitPayload(b, 1, uint64(prefix-0xc0), true)
+	default:
+		// Long list
+		size, err := rlpReadSize(b, int(prefix-0xf7))
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return rlpSplitPayload(b, 1+int(prefix-0xf7), size, true)
+	}
+}
+
+func rlpSplitPayload(b []byte, offset int, size uint64, isList bool) ([]byte, []byte, bool, error) {
+	if uint64(len(b)-offset) < size {
+		return nil, nil, false, errors.New("RLP value is too short")
+	}
+
+	end := offset + int(size)
+	return b[offset:end], b[end:], isList, nil
+}
+
+func rlpReadSize(b []byte, sizeLength int) (uint64, error) {
+	if len(b) < 1+sizeLength || sizeLength > 8 {
+		return 0, errors.New("RLP size is invalid")
+	}
+
+	size := uint64(0)
+	for _, s := range b[1 : 1+sizeLength] {
+		size = (size << 8) | uint64(s)
+	}
+
+	return size, nil
+}
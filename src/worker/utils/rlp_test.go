@@ -0,0 +1,34 @@
+//+build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRLPHexToBigIntList(t *testing.T) {
+	assert := assert.New(t)
+
+	// [1, 2, 1024]
+	values, err := RLPHexToBigIntList("0xc50102820400")
+	assert.Equal(nil, err)
+	assert.Equal(3, len(values))
+	assert.Equal(int64(1), values[0].Int64())
+	assert.Equal(int64(2), values[1].Int64())
+	assert.Equal(int64(1024), values[2].Int64())
+
+	// []
+	values, err = RLPHexToBigIntList("0xc0")
+	assert.Equal(nil, err)
+	assert.Equal(0, len(values))
+
+	// Not a list
+	_, err = RLPHexToBigIntList("0x820400")
+	assert.NotEqual(nil, err)
+
+	// Truncated
+	_, err = RLPHexToBigIntList("0xc501028204")
+	assert.NotEqual(nil, err)
+}
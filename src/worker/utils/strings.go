@@ -2,6 +2,7 @@ package utils
 
 import (
 	"math/big"
+	"strings"
 
 	"go.uber.org/zap"
 )
@@ -41,3 +42,14 @@ func StringHexToFloat64(hex string, base int) float64 {
 
 	return valueDecimal
 }
+
+// StringHexEqual - compare two 0x prefixed hex strings by value
+func StringHexEqual(a string, b string) bool {
+	aBigInt, aSuccess := new(big.Int).SetString(strings.TrimPrefix(a, "0x"), 16)
+	bBigInt, bSuccess := new(big.Int).SetString(strings.TrimPrefix(b, "0x"), 16)
+	if aSuccess == false || bSuccess == false {
+		return a == b
+	}
+
+	return aBigInt.Cmp(bBigInt) == 0
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Contracts test
+func TestTransactionsContractsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Test contracts
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/contracts")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	address := bodyList[0]["address"].(string)
+	deployerAddress := bodyList[0]["deployer_address"].(string)
+	status := bodyList[0]["status"].(string)
+
+	// Test deployer and status filters
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/contracts?deployer=" + deployerAddress + "&status=" + status)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList = make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	for _, contract := range bodyList {
+		assert.Equal(deployerAddress, contract["deployer_address"].(string))
+		assert.Equal(status, contract["status"].(string))
+	}
+
+	// Test contract
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/contracts/" + address)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bytes, &body)
+	assert.Equal(nil, err)
+	assert.Equal(address, body["address"].(string))
+	assert.NotEqual(0, len(body["updates"].([]interface{})))
+
+	// Test invalid status
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/contracts?status=deployed")
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
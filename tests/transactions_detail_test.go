@@ -60,3 +60,34 @@ func TestTransactionsEndpointDetail(t *testing.T) {
 	transactionHashResult := bodyMapResult["hash"].(string)
 	assert.Equal(transactionHash, transactionHashResult)
 }
+
+// Missing hash test
+func TestTransactionsEndpointDetailNotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/details/0x0000000000000000000000000000000000000000000000000000000000000000")
+	assert.Equal(nil, err)
+	assert.Equal(404, resp.StatusCode)
+	assert.NotEqual("", resp.Header.Get("X-Request-ID"))
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyMap := make(map[string]map[string]interface{})
+	err = json.Unmarshal(bytes, &bodyMap)
+	assert.Equal(nil, err)
+
+	assert.Equal("not_found", bodyMap["error"]["code"])
+	assert.Equal(resp.Header.Get("X-Request-ID"), bodyMap["error"]["request_id"])
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Batch transaction details test
+func TestTransactionsDetailsBatchEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Get latest transactions
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?type=regular&limit=5")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(body, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	hashes := []string{}
+	for _, transaction := range bodyList {
+		hashes = append(hashes, transaction["hash"].(string))
+	}
+	missingHash := "0x0000000000000000000000000000000000000000000000000000000000000000"
+	hashes = append(hashes, missingHash)
+
+	// Test batch details
+	requestBody, _ := json.Marshal(map[string]interface{}{"hashes": hashes})
+	resp, err = http.Post(transactionsServiceURL+transactionsServiceRestPrefx+"/transactions/details", "application/json", bytes.NewReader(requestBody))
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	body, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	batch := struct {
+		Transactions []map[string]interface{} `json:"transactions"`
+		NotFound     []string                 `json:"not_found"`
+	}{}
+	err = json.Unmarshal(body, &batch)
+	assert.Equal(nil, err)
+
+	assert.Equal(len(bodyList), len(batch.Transactions))
+	assert.Equal([]string{missingHash}, batch.NotFound)
+	for i, transaction := range batch.Transactions {
+		assert.Equal(hashes[i], transaction["hash"].(string))
+		assert.NotEqual(nil, transaction["internal_transactions"])
+		assert.NotEqual(nil, transaction["token_transfers"])
+	}
+
+	// Test empty batch
+	resp, err = http.Post(transactionsServiceURL+transactionsServiceRestPrefx+"/transactions/details", "application/json", bytes.NewReader([]byte(`{"hashes": []}`)))
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
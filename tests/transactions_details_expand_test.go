@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Transaction details expand test
+func TestTransactionsDetailsExpandEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Get latest transaction
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?type=regular&limit=1")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	hash := bodyList[0]["hash"].(string)
+
+	// Test expand
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/details/" + hash + "?expand=internal,token_transfers,logs")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	body := map[string]interface{}{}
+	err = json.Unmarshal(bytes, &body)
+	assert.Equal(nil, err)
+	assert.Equal(hash, body["hash"].(string))
+	assert.NotEqual(nil, body["internal_transactions"])
+	assert.NotEqual(nil, body["token_transfers"])
+	assert.NotEqual(nil, body["logs"])
+
+	// Test invalid expand
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/details/" + hash + "?expand=blocks")
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Fee stats test
+func TestTransactionsFeeStatsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Test fee stats
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/stats/fees?period=hourly")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	for _, stat := range bodyList {
+		assert.Equal("hourly", stat["period"])
+		assert.Contains(stat, "average_fee")
+		assert.Contains(stat, "average_step_used")
+		assert.Contains(stat, "average_step_price")
+	}
+
+	// Test invalid period
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/stats/fees?period=weekly")
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+
+	defer resp.Body.Close()
+}
+
+// Address and contract fee stats test
+func TestTransactionsAddressContractFeeStatsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Get latest transactions
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?type=transaction&limit=25")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	// Test address fee stats
+	fromAddress := bodyList[0]["from_address"].(string)
+
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/address/" + fromAddress + "/fees")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	statList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &statList)
+	assert.Equal(nil, err)
+
+	for _, stat := range statList {
+		assert.Equal(fromAddress, stat["address"])
+	}
+
+	// Test contract fee stats
+	for _, transaction := range bodyList {
+		toAddress, _ := transaction["to_address"].(string)
+		if !strings.HasPrefix(toAddress, "cx") {
+			continue
+		}
+
+		resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/contracts/" + toAddress + "/fees")
+		assert.Equal(nil, err)
+		assert.Equal(200, resp.StatusCode)
+
+		defer resp.Body.Close()
+
+		bytes, err = ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		statList = make([]map[string]interface{}, 0)
+		err = json.Unmarshal(bytes, &statList)
+		assert.Equal(nil, err)
+
+		for _, stat := range statList {
+			assert.Equal(toAddress, stat["contract_address"])
+		}
+		break
+	}
+}
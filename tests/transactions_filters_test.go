@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Transaction list filters test
+func TestTransactionsFiltersEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Test value range, status, and sort
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?type=regular&status=success&min_value=1&sort_by=value&sort=desc")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	for i, transaction := range bodyList {
+		assert.Equal(float64(1), transaction["receipt_status"].(float64))
+		assert.GreaterOrEqual(transaction["value_decimal"].(float64), float64(1))
+
+		if i > 0 {
+			assert.LessOrEqual(transaction["value_decimal"].(float64), bodyList[i-1]["value_decimal"].(float64))
+		}
+	}
+
+	// Test address lists
+	fromAddress := bodyList[0]["from_address"].(string)
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?from=hx0000000000000000000000000000000000000000," + fromAddress)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList = make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	// Test filtered total count
+	totalCount, err := strconv.Atoi(resp.Header.Get("X-TOTAL-COUNT"))
+	assert.Equal(nil, err)
+	assert.GreaterOrEqual(totalCount, len(bodyList))
+	if resp.Header.Get("X-TOTAL-COUNT-ESTIMATED") != "true" {
+		assert.Less(totalCount, 10000)
+	}
+
+	// Test invalid status
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions?status=pending")
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
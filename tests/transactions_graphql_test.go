@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// GraphQL test
+func TestTransactionsGraphQLEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	query := `{
+		transactions(first: 5) {
+			edges {
+				cursor
+				node {
+					hash
+					from_address
+					receipt_step_used
+					from { address transaction_count }
+					internal_transactions { hash }
+					token_transfers { log_index token_contract { address symbol } }
+				}
+			}
+			page_info { has_next_page end_cursor }
+		}
+	}`
+
+	requestBody, _ := json.Marshal(map[string]interface{}{"query": query})
+	resp, err := http.Post(
+		transactionsServiceURL+transactionsServiceRestPrefx+"/transactions/graphql",
+		"application/json",
+		bytes.NewReader(requestBody),
+	)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	body := map[string]interface{}{}
+	err = json.Unmarshal(respBytes, &body)
+	assert.Equal(nil, err)
+	assert.Nil(body["errors"])
+
+	transactions := body["data"].(map[string]interface{})["transactions"].(map[string]interface{})
+	edges := transactions["edges"].([]interface{})
+	assert.NotEqual(0, len(edges))
+
+	for _, e := range edges {
+		node := e.(map[string]interface{})["node"].(map[string]interface{})
+		assert.NotEqual("", node["hash"])
+		assert.Equal(node["from_address"], node["from"].(map[string]interface{})["address"])
+	}
+
+	// Next page
+	endCursor := transactions["page_info"].(map[string]interface{})["end_cursor"].(string)
+
+	requestBody, _ = json.Marshal(map[string]interface{}{
+		"query":     `query Page($after: String) { transactions(first: 5, after: $after) { edges { node { hash } } } }`,
+		"variables": map[string]interface{}{"after": endCursor},
+	})
+	resp, err = http.Post(
+		transactionsServiceURL+transactionsServiceRestPrefx+"/transactions/graphql",
+		"application/json",
+		bytes.NewReader(requestBody),
+	)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	// Over max cost
+	requestBody, _ = json.Marshal(map[string]interface{}{
+		"query": `{ transactions(first: 100) { edges { node { from { transactions(first: 100) { edges { node { hash } } } } } } } }`,
+	})
+	resp, err = http.Post(
+		transactionsServiceURL+transactionsServiceRestPrefx+"/transactions/graphql",
+		"application/json",
+		bytes.NewReader(requestBody),
+	)
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+
+	defer resp.Body.Close()
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Ranked token holders test
+func TestTransactionsTokenHoldersRankedEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Get latest token transfer
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-transfers?limit=1")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	tokenContractAddress := bodyList[0]["token_contract_address"].(string)
+
+	// Test ranked holders
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-holders/token-contract/" + tokenContractAddress + "?limit=5&skip=5")
+	assert.Equal(nil, err)
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		bytes, err = ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		bodyList = make([]map[string]interface{}, 0)
+		err = json.Unmarshal(bytes, &bodyList)
+		assert.Equal(nil, err)
+
+		for i, holder := range bodyList {
+			assert.Equal(float64(5+i+1), holder["rank"])
+			assert.Contains(holder, "percentage")
+		}
+	}
+
+	// Test distribution
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-holders/token-contract/" + tokenContractAddress + "/distribution")
+	assert.Equal(nil, err)
+	assert.Contains([]int{200, 404}, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		bytes, err = ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		body := map[string]interface{}{}
+		err = json.Unmarshal(bytes, &body)
+		assert.Equal(nil, err)
+
+		assert.Equal(tokenContractAddress, body["token_contract_address"])
+		assert.GreaterOrEqual(body["gini"].(float64), 0.0)
+		assert.LessOrEqual(body["gini"].(float64), 1.0)
+	}
+}
+
+// ICX rich list test
+func TestTransactionsIcxHoldersEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Test rich list
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/icx-holders?limit=10")
+	assert.Equal(nil, err)
+	assert.Contains([]int{200, 204}, resp.StatusCode)
+	assert.NotEqual("", resp.Header.Get("X-TOTAL-COUNT"))
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		bytes, err := ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		bodyList := make([]map[string]interface{}, 0)
+		err = json.Unmarshal(bytes, &bodyList)
+		assert.Equal(nil, err)
+
+		for i, holder := range bodyList {
+			assert.Equal(float64(i+1), holder["rank"])
+			if i > 0 {
+				assert.LessOrEqual(holder["value_decimal"].(float64), bodyList[i-1]["value_decimal"].(float64))
+			}
+		}
+	}
+
+	// Test distribution
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/icx-holders/distribution")
+	assert.Equal(nil, err)
+	assert.Contains([]int{200, 404}, resp.StatusCode)
+
+	defer resp.Body.Close()
+}
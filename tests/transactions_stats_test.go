@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Daily and hourly stats test
+func TestTransactionsStatsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	for _, period := range []string{"daily", "hourly"} {
+		resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/stats/" + period)
+		assert.Equal(nil, err)
+		assert.Equal(200, resp.StatusCode)
+
+		defer resp.Body.Close()
+
+		bytes, err := ioutil.ReadAll(resp.Body)
+		assert.Equal(nil, err)
+
+		bodyList := make([]map[string]interface{}, 0)
+		err = json.Unmarshal(bytes, &bodyList)
+		assert.Equal(nil, err)
+		assert.NotEqual(0, len(bodyList))
+
+		for i, stat := range bodyList {
+			assert.Equal(period, stat["period"].(string))
+			assert.GreaterOrEqual(stat["transaction_count"].(float64), float64(0))
+			assert.GreaterOrEqual(stat["active_address_count"].(float64), float64(0))
+
+			// Latest buckets first
+			if i > 0 {
+				assert.Less(stat["bucket_timestamp"].(float64), bodyList[i-1]["bucket_timestamp"].(float64))
+			}
+		}
+	}
+
+	// Test invalid timestamp
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/stats/daily?start_timestamp=yesterday")
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}
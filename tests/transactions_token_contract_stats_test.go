@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Token contract stats test
+func TestTransactionsTokenContractStatsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	transactionsServiceURL := os.Getenv("TRANSACTIONS_SERVICE_URL")
+	if transactionsServiceURL == "" {
+		transactionsServiceURL = "http://localhost:8000"
+	}
+	transactionsServiceRestPrefx := os.Getenv("TRANSACTIONS_SERVICE_REST_PREFIX")
+	if transactionsServiceRestPrefx == "" {
+		transactionsServiceRestPrefx = "/api/v1"
+	}
+
+	// Get latest token transfer
+	resp, err := http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-transfers?limit=1")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList := make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	tokenContractAddress := bodyList[0]["token_contract_address"].(string)
+	blockTimestamp := int64(bodyList[0]["block_timestamp"].(float64))
+
+	// Test stats
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-contracts/" + tokenContractAddress + "/stats")
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList = make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	for _, stat := range bodyList {
+		assert.Equal(tokenContractAddress, stat["token_contract_address"].(string))
+	}
+
+	// Test top movers around the latest transfer
+	endTimestamp := strconv.FormatInt(blockTimestamp, 10)
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-contracts/" + tokenContractAddress + "/stats/top-movers?end_timestamp=" + endTimestamp)
+	assert.Equal(nil, err)
+	assert.Equal(200, resp.StatusCode)
+
+	defer resp.Body.Close()
+
+	bytes, err = ioutil.ReadAll(resp.Body)
+	assert.Equal(nil, err)
+
+	bodyList = make([]map[string]interface{}, 0)
+	err = json.Unmarshal(bytes, &bodyList)
+	assert.Equal(nil, err)
+	assert.NotEqual(0, len(bodyList))
+
+	for i, mover := range bodyList {
+		moved := mover["sent_value"].(float64) + mover["received_value"].(float64)
+		if i > 0 {
+			assert.LessOrEqual(moved, bodyList[i-1]["sent_value"].(float64)+bodyList[i-1]["received_value"].(float64))
+		}
+	}
+
+	// Test window too long
+	startTimestamp := strconv.FormatInt(blockTimestamp-40*24*3600*1000000, 10)
+	resp, err = http.Get(transactionsServiceURL + transactionsServiceRestPrefx + "/transactions/token-contracts/" + tokenContractAddress + "/stats/top-movers?start_timestamp=" + startTimestamp + "&end_timestamp=" + endTimestamp)
+	assert.Equal(nil, err)
+	assert.Equal(422, resp.StatusCode)
+}